@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	"github.com/observeinc/terraform-provider-observe/client/oid"
 	"github.com/observeinc/terraform-provider-observe/client/rest"
 )
@@ -200,6 +201,41 @@ func (c *Client) LookupDataset(ctx context.Context, workspaceID string, name str
 	return c.Meta.LookupDataset(ctx, workspaceID, name)
 }
 
+// SearchDatasets by label, column, key and other attributes.
+func (c *Client) SearchDatasets(ctx context.Context, filter meta.DatasetSearchFilter) ([]meta.DatasetMatch, error) {
+	return c.Meta.SearchDatasets(ctx, filter)
+}
+
+// SearchDashboards by name, folder, parameter and input dataset.
+func (c *Client) SearchDashboards(ctx context.Context, terms meta.DWSearchInput, maxCount *types.Int64Scalar) (*meta.DashboardSearchResultWrapper, error) {
+	return c.Meta.SearchDashboards(ctx, terms, maxCount)
+}
+
+// SearchWorksheets by name, folder, parameter and input dataset.
+func (c *Client) SearchWorksheets(ctx context.Context, terms meta.DWSearchInput, maxCount *types.Int64Scalar) (*meta.WorksheetSearchResultWrapper, error) {
+	return c.Meta.SearchWorksheets(ctx, terms, maxCount)
+}
+
+// SearchMetrics by dataset, correlation tag and name.
+func (c *Client) SearchMetrics(ctx context.Context, filter meta.MetricSearchFilter) (*meta.MetricSearchResult, error) {
+	return c.Meta.SearchMetrics(ctx, filter)
+}
+
+// SearchMetricTagValues returns the values seen for a metric tag.
+func (c *Client) SearchMetricTagValues(ctx context.Context, filter meta.MetricTagValuesFilter) (*meta.MetricTagValues, error) {
+	return c.Meta.SearchMetricTagValues(ctx, filter)
+}
+
+// ListDatasetLineage returns the inputs and foreign keys of every dataset.
+func (c *Client) ListDatasetLineage(ctx context.Context) ([]meta.DatasetLineageNode, error) {
+	return c.Meta.ListDatasetLineage(ctx)
+}
+
+// ListMonitorV2Lineage returns the input datasets of every monitor.
+func (c *Client) ListMonitorV2Lineage(ctx context.Context) ([]meta.MonitorV2LineageNode, error) {
+	return c.Meta.ListMonitorV2Lineage(ctx)
+}
+
 // CreateForeignKey
 func (c *Client) CreateForeignKey(ctx context.Context, workspaceID string, input *meta.DeferredForeignKeyInput) (*meta.DeferredForeignKey, error) {
 	if !c.Flags[flagObs2110] {
@@ -682,10 +718,49 @@ func (c *Client) LookupMonitorV2(ctx context.Context, workspaceId *string, nameE
 	return c.Meta.LookupMonitorV2(ctx, workspaceId, nameExact)
 }
 
+func (c *Client) SearchMonitorV2s(ctx context.Context, workspaceId *string, folderId *string, nameSubstring *string) ([]meta.MonitorV2, error) {
+	return c.Meta.SearchMonitorV2s(ctx, workspaceId, folderId, nameSubstring)
+}
+
 func (c *Client) SearchMonitorV2Action(ctx context.Context, workspaceId *string, nameExact *string) ([]meta.MonitorV2Action, error) {
 	return c.Meta.SearchMonitorV2Action(ctx, workspaceId, nameExact)
 }
 
+func (c *Client) CreateMonitorV2MuteRule(ctx context.Context, workspaceId string, input *meta.MonitorV2MuteRuleInput) (*meta.MonitorV2MuteRule, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateMonitorV2MuteRule(ctx, workspaceId, input)
+}
+
+func (c *Client) UpdateMonitorV2MuteRule(ctx context.Context, id string, input *meta.MonitorV2MuteRuleInput) (*meta.MonitorV2MuteRule, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateMonitorV2MuteRule(ctx, id, input)
+}
+
+func (c *Client) GetMonitorV2MuteRule(ctx context.Context, id string) (*meta.MonitorV2MuteRule, error) {
+	return c.Meta.GetMonitorV2MuteRule(ctx, id)
+}
+
+func (c *Client) DeleteMonitorV2MuteRule(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteMonitorV2MuteRule(ctx, id)
+}
+
+func (c *Client) SearchMonitorV2MuteRule(ctx context.Context, workspaceId *string, nameExact *string) ([]meta.MonitorV2MuteRule, error) {
+	return c.Meta.SearchMonitorV2MuteRule(ctx, workspaceId, nameExact)
+}
+
 // CreateMonitorActionAttachment creates a monitor action attachment
 func (c *Client) CreateMonitorActionAttachment(ctx context.Context, input *meta.MonitorActionAttachmentInput) (*meta.MonitorActionAttachment, error) {
 	if !c.Flags[flagObs2110] {
@@ -995,6 +1070,30 @@ func (c *Client) ClearDefaultDashboard(ctx context.Context, dsid string) error {
 	return c.Meta.ClearDefaultDashboard(ctx, dsid)
 }
 
+func (c *Client) GetDefaultInstanceDashboard(ctx context.Context, dsid string) (*string, error) {
+	return c.Meta.GetDefaultInstanceDashboard(ctx, dsid)
+}
+
+func (c *Client) SetDefaultInstanceDashboard(ctx context.Context, dsid string, dashid string) error {
+	return c.Meta.SetDefaultInstanceDashboard(ctx, dsid, dashid)
+}
+
+func (c *Client) ClearDefaultInstanceDashboard(ctx context.Context, dsid string) error {
+	return c.Meta.ClearDefaultInstanceDashboard(ctx, dsid)
+}
+
+func (c *Client) GetDefaultDashboardForTag(ctx context.Context, tag string) (*string, error) {
+	return c.Meta.GetDefaultDashboardForTag(ctx, tag)
+}
+
+func (c *Client) SetDefaultDashboardForTag(ctx context.Context, tag string, dashid string) error {
+	return c.Meta.SetDefaultDashboardForTag(ctx, tag, dashid)
+}
+
+func (c *Client) GetTagsDashboardIsDefaultFor(ctx context.Context, dashid string) ([]string, error) {
+	return c.Meta.GetTagsDashboardIsDefaultFor(ctx, dashid)
+}
+
 // CreateFolder creates a folder
 func (c *Client) CreateFolder(ctx context.Context, workspaceId string, input *meta.FolderInput) (*meta.Folder, error) {
 	if !c.Flags[flagObs2110] {
@@ -1307,6 +1406,146 @@ func (c *Client) ListUsers(ctx context.Context) ([]meta.User, error) {
 	return c.Meta.ListUsers(ctx)
 }
 
+// InviteUser invites a user by email
+func (c *Client) InviteUser(ctx context.Context, input *meta.UserInput) (*meta.User, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.InviteUser(ctx, input)
+}
+
+// UpdateUser updates a user
+func (c *Client) UpdateUser(ctx context.Context, id string, input *meta.UserInput) (*meta.User, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateUser(ctx, id, input)
+}
+
+func (c *Client) GetCurrentCustomerSso(ctx context.Context) (*meta.CustomerSso, error) {
+	return c.Meta.GetCurrentCustomerSso(ctx)
+}
+
+func (c *Client) UpdateCurrentCustomerSso(ctx context.Context, input *meta.CustomerSsoInput) (*meta.CustomerSso, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateCurrentCustomerSso(ctx, input)
+}
+
+func (c *Client) GetPasswordPolicy(ctx context.Context) (*meta.PasswordPolicy, error) {
+	return c.Meta.GetPasswordPolicy(ctx)
+}
+
+func (c *Client) UpdatePasswordPolicy(ctx context.Context, input *meta.PasswordPolicyInput) (*meta.PasswordPolicy, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdatePasswordPolicy(ctx, input)
+}
+
+// GetWorkspaceObjectOwner returns the owner of a workspace object
+func (c *Client) GetWorkspaceObjectOwner(ctx context.Context, id oid.OID) (types.UserIdScalar, error) {
+	return c.Meta.GetWorkspaceObjectOwner(ctx, id)
+}
+
+// SetWorkspaceObjectOwner transfers ownership of a workspace object
+func (c *Client) SetWorkspaceObjectOwner(ctx context.Context, id string, owner types.UserIdScalar) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.SetWorkspaceObjectOwner(ctx, id, owner)
+}
+
+func (c *Client) GetKubernetesContent(ctx context.Context) (*meta.KubernetesContent, error) {
+	return c.Meta.GetKubernetesContent(ctx)
+}
+
+func (c *Client) UpdateKubernetesContent(ctx context.Context, input *meta.KubernetesContentInput, action *meta.RematerializationAction) (*meta.KubernetesContent, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateKubernetesContent(ctx, input, action)
+}
+
+func (c *Client) DeleteKubernetesContent(ctx context.Context) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteKubernetesContent(ctx)
+}
+
+func (c *Client) GetHostExplorerContent(ctx context.Context) (*meta.HostExplorerContent, error) {
+	return c.Meta.GetHostExplorerContent(ctx)
+}
+
+func (c *Client) UpdateHostExplorerContent(ctx context.Context, input *meta.HostExplorerContentInput) (*meta.HostExplorerContent, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateHostExplorerContent(ctx, input)
+}
+
+func (c *Client) DeleteHostExplorerContent(ctx context.Context) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteHostExplorerContent(ctx)
+}
+
+func (c *Client) GetTracingContent(ctx context.Context) (*meta.TracingContent, error) {
+	return c.Meta.GetTracingContent(ctx)
+}
+
+func (c *Client) InstallTracingContent(ctx context.Context, input *meta.TracingContentInput) (*meta.TracingContent, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.InstallTracingContent(ctx, input)
+}
+
+func (c *Client) GetLlmContent(ctx context.Context) (*meta.LlmContent, error) {
+	return c.Meta.GetLlmContent(ctx)
+}
+
+func (c *Client) InstallLlmContent(ctx context.Context, input *meta.LlmContentInput) (*meta.LlmContent, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.InstallLlmContent(ctx, input)
+}
+
+func (c *Client) GetMonitoringContent(ctx context.Context) (*meta.MonitoringContent, error) {
+	return c.Meta.GetMonitoringContent(ctx)
+}
+
+func (c *Client) InstallMonitoringContent(ctx context.Context, input *meta.MonitoringContentInput) (*meta.MonitoringContent, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.InstallMonitoringContent(ctx, input)
+}
+
+func (c *Client) DeleteMonitoringContent(ctx context.Context) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteMonitoringContent(ctx)
+}
+
 // CreateRbacGroupmember creates an rbacgroupmember
 func (c *Client) CreateRbacGroupmember(ctx context.Context, input *meta.RbacGroupmemberInput) (*meta.RbacGroupmember, error) {
 	if !c.Flags[flagObs2110] {
@@ -1330,6 +1569,25 @@ func (c *Client) GetRbacGroupmember(ctx context.Context, id string) (*meta.RbacG
 	return c.Meta.GetRbacGroupmember(ctx, id)
 }
 
+// GetRbacGroupmembers returns all direct members of a group
+func (c *Client) GetRbacGroupmembers(ctx context.Context, groupId string) ([]meta.RbacGroupmember, error) {
+	return c.Meta.GetRbacGroupmembers(ctx, groupId)
+}
+
+// SetRbacGroupmembers replaces all direct members of a group
+func (c *Client) SetRbacGroupmembers(ctx context.Context, groupId string, memberUsers []types.UserIdScalar, memberGroups []string) ([]meta.RbacGroupmember, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.SetRbacGroupmembers(ctx, groupId, memberUsers, memberGroups)
+}
+
+// AccessCheck evaluates whether a user may perform a list of actions
+func (c *Client) AccessCheck(ctx context.Context, checks []meta.AccessCheckInput, userId *types.UserIdScalar) ([]meta.AccessCheckResult, error) {
+	return c.Meta.AccessCheck(ctx, checks, userId)
+}
+
 // CreateRbacStatement creates an rbacstatement
 func (c *Client) CreateRbacStatement(ctx context.Context, input *meta.RbacStatementInput) (*meta.RbacStatement, error) {
 	if !c.Flags[flagObs2110] {
@@ -1485,6 +1743,309 @@ func (c *Client) DeleteDatasetOutboundShare(ctx context.Context, id string) erro
 	return c.Meta.DeleteDatasetOutboundShare(ctx, id)
 }
 
+func (c *Client) GetDataExportJob(ctx context.Context, id string) (*meta.DataExportJob, error) {
+	return c.Meta.GetDataExportJob(ctx, id)
+}
+
+func (c *Client) CreateDataExportJob(ctx context.Context, workspaceId string, input *meta.DataExportJobInput) (*meta.DataExportJob, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateDataExportJob(ctx, workspaceId, input)
+}
+
+func (c *Client) UpdateDataExportJob(ctx context.Context, id string, input *meta.DataExportJobInput) (*meta.DataExportJob, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateDataExportJob(ctx, id, input)
+}
+
+func (c *Client) DeleteDataExportJob(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteDataExportJob(ctx, id)
+}
+
+func (c *Client) RetryDataExportJob(ctx context.Context, id string) (*meta.DataExportJob, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.RetryDataExportJob(ctx, id)
+}
+
+func (c *Client) SearchDataExportJob(ctx context.Context, workspaceId *string, nameExact *string) ([]meta.DataExportJob, error) {
+	return c.Meta.SearchDataExportJob(ctx, workspaceId, nameExact)
+}
+
+func (c *Client) GetDataExportDestinationConfig(ctx context.Context, workspaceId *string) (*meta.DataExportDestinationConfig, error) {
+	return c.Meta.GetDataExportDestinationConfig(ctx, workspaceId)
+}
+
+func (c *Client) GetInvestigationNotebook(ctx context.Context, id string) (*meta.InvestigationNotebook, error) {
+	return c.Meta.GetInvestigationNotebook(ctx, id)
+}
+
+func (c *Client) CreateInvestigationNotebook(ctx context.Context, workspaceId string, input *meta.InvestigationNotebookInput) (*meta.InvestigationNotebook, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateInvestigationNotebook(ctx, workspaceId, input)
+}
+
+func (c *Client) UpdateInvestigationNotebook(ctx context.Context, id string, input *meta.InvestigationNotebookInput) (*meta.InvestigationNotebook, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateInvestigationNotebook(ctx, id, input)
+}
+
+func (c *Client) DeleteInvestigationNotebook(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteInvestigationNotebook(ctx, id)
+}
+
+func (c *Client) SearchInvestigationNotebook(ctx context.Context, workspaceId *string, nameExact *string) ([]meta.InvestigationNotebook, error) {
+	return c.Meta.SearchInvestigationNotebook(ctx, workspaceId, nameExact)
+}
+
+func (c *Client) GetIncident(ctx context.Context, id string) (*meta.Incident, error) {
+	return c.Meta.GetIncident(ctx, id)
+}
+
+func (c *Client) CreateIncident(ctx context.Context, workspaceId string, input *meta.IncidentInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateIncident(ctx, workspaceId, input)
+}
+
+func (c *Client) UpdateIncident(ctx context.Context, id string, input *meta.IncidentInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateIncident(ctx, id, input)
+}
+
+func (c *Client) DeleteIncident(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteIncident(ctx, id)
+}
+
+func (c *Client) AddIncidentSlackChannels(ctx context.Context, id string, channels []meta.IncidentSlackchannelInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.AddIncidentSlackChannels(ctx, id, channels)
+}
+
+func (c *Client) RemoveIncidentSlackChannels(ctx context.Context, id string, channels []meta.IncidentSlackchannelInput) (*meta.Incident, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.RemoveIncidentSlackChannels(ctx, id, channels)
+}
+
+func (c *Client) SearchIncident(ctx context.Context, workspaceId *string, nameExact *string) ([]meta.Incident, error) {
+	return c.Meta.SearchIncident(ctx, workspaceId, nameExact)
+}
+
+func (c *Client) GetDataConnection(ctx context.Context, id string) (*meta.DataConnection, error) {
+	return c.Meta.GetDataConnection(ctx, id)
+}
+
+func (c *Client) CreateDataConnection(ctx context.Context, workspaceId string, input *meta.DataConnectionInput) (*meta.DataConnection, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateDataConnection(ctx, workspaceId, input)
+}
+
+func (c *Client) UpdateDataConnection(ctx context.Context, id string, input *meta.DataConnectionInput) (*meta.DataConnection, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateDataConnection(ctx, id, input)
+}
+
+func (c *Client) DeleteDataConnection(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteDataConnection(ctx, id)
+}
+
+func (c *Client) SearchDataConnection(ctx context.Context, workspaceId *string, nameExact *string) ([]meta.DataConnection, error) {
+	return c.Meta.SearchDataConnection(ctx, workspaceId, nameExact)
+}
+
+func (c *Client) GetLatestDataConnectionModuleVersion(ctx context.Context, workspaceId *string, moduleId string) (string, error) {
+	return c.Meta.GetLatestDataConnectionModuleVersion(ctx, workspaceId, moduleId)
+}
+
+func (c *Client) GetDatasource(ctx context.Context, id string) (*meta.Datasource, error) {
+	return c.Meta.GetDatasource(ctx, id)
+}
+
+func (c *Client) CreateDatasource(ctx context.Context, workspaceId string, input *meta.DatasourceInput) (*meta.Datasource, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	if c.Config.ManagingObjectID != nil {
+		input.ManagedById = c.Config.ManagingObjectID
+	}
+	return c.Meta.CreateDatasource(ctx, workspaceId, input)
+}
+
+func (c *Client) UpdateDatasource(ctx context.Context, id string, input *meta.DatasourceInput) (*meta.Datasource, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateDatasource(ctx, id, input)
+}
+
+func (c *Client) DeleteDatasource(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteDatasource(ctx, id)
+}
+
+func (c *Client) SearchDatasource(ctx context.Context, workspaceId *string, nameExact *string) ([]meta.Datasource, error) {
+	return c.Meta.SearchDatasource(ctx, workspaceId, nameExact)
+}
+
+func (c *Client) GetDatasourcesByConnection(ctx context.Context, dataConnectionId string) ([]meta.Datasource, error) {
+	return c.Meta.GetDatasourcesByConnection(ctx, dataConnectionId)
+}
+
+func (c *Client) GetAuthtoken(ctx context.Context, id string) (*meta.Authtoken, error) {
+	return c.Meta.GetAuthtoken(ctx, id)
+}
+
+func (c *Client) CreateAuthtoken(ctx context.Context, input *meta.AuthtokenInput, owningUser *types.UserIdScalar, replaceTokenId *string) (*meta.Authtoken, string, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.CreateAuthtoken(ctx, input, owningUser, replaceTokenId)
+}
+
+func (c *Client) UpdateAuthtoken(ctx context.Context, id string, input *meta.AuthtokenInput, newOwningUser *types.UserIdScalar) (*meta.Authtoken, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.UpdateAuthtoken(ctx, id, input, newOwningUser)
+}
+
+func (c *Client) DeleteAuthtoken(ctx context.Context, id string) error {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.DeleteAuthtoken(ctx, id)
+}
+
+func (c *Client) SearchAuthtokens(ctx context.Context, kinds []meta.AuthtokenKind, user *types.UserIdScalar) ([]meta.Authtoken, error) {
+	return c.Meta.SearchAuthtokens(ctx, kinds, user)
+}
+
+func (c *Client) GetAccelerationJob(ctx context.Context, jobId string) (*meta.AccelerationJob, error) {
+	return c.Meta.GetAccelerationJob(ctx, jobId)
+}
+
+func (c *Client) CreateAccelerationJob(ctx context.Context, input *meta.AccelerationJobInput) (*meta.AccelerationJob, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.CreateAccelerationJob(ctx, input)
+}
+
+func (c *Client) CancelAccelerationJob(ctx context.Context, jobId string) (*meta.AccelerationJob, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.CancelAccelerationJob(ctx, jobId)
+}
+
+func (c *Client) EstimateAccelerationJobCost(ctx context.Context, input *meta.AccelerationJobInput) ([]meta.DatasetCostEstimate, error) {
+	return c.Meta.EstimateAccelerationJobCost(ctx, input)
+}
+
+func (c *Client) HibernationAction(ctx context.Context, datasetId string, action meta.HibernationActionType, dryRun bool) (*meta.HibernationActionResponse, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.HibernationAction(ctx, datasetId, action, dryRun)
+}
+
+func (c *Client) GetDatasetHibernatedAt(ctx context.Context, datasetId string) (*types.TimeScalar, error) {
+	return c.Meta.GetDatasetHibernatedAt(ctx, datasetId)
+}
+
+func (c *Client) GetDatasetUnhealthyActions(ctx context.Context, datasetId string) ([]string, error) {
+	return c.Meta.GetDatasetUnhealthyActions(ctx, datasetId)
+}
+
+func (c *Client) SetDatasetUnhealthyActions(ctx context.Context, datasetId string, actionIds []string) ([]string, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.SetDatasetUnhealthyActions(ctx, datasetId, actionIds)
+}
+
+func (c *Client) GetDefaultDatasetUnhealthyActions(ctx context.Context) ([]string, error) {
+	return c.Meta.GetDefaultDatasetUnhealthyActions(ctx)
+}
+
+func (c *Client) SetDefaultDatasetUnhealthyActions(ctx context.Context, actionIds []string) ([]string, error) {
+	if !c.Flags[flagObs2110] {
+		c.obs2110.Lock()
+		defer c.obs2110.Unlock()
+	}
+	return c.Meta.SetDefaultDatasetUnhealthyActions(ctx, actionIds)
+}
+
 func (c *Client) CreateCorrelationTag(ctx context.Context, dataset, tag string, path meta.LinkFieldInput) error {
 	if !c.Flags[flagObs2110] {
 		c.obs2110.Lock()
@@ -1666,6 +2227,10 @@ func (c *Client) DeleteIngestToken(ctx context.Context, id string) error {
 	return c.Meta.DeleteIngestToken(ctx, id)
 }
 
+func (c *Client) UpdateIngestTokenAssociation(ctx context.Context, id string, datastreamIds []string) error {
+	return c.Meta.UpdateIngestTokenAssociation(ctx, id, datastreamIds)
+}
+
 func (c *Client) CreateIngestFilter(ctx context.Context, req *rest.IngestFilterCreateRequest) (result *rest.IngestFilterResource, err error) {
 	c.maybeRunConcurrently(func() {
 		result, err = c.Rest.CreateIngestFilter(ctx, req)
@@ -0,0 +1,17 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+// AccessCheck evaluates whether a user may perform each action. If no user is
+// provided, the checks are evaluated for the current user.
+func (client *Client) AccessCheck(ctx context.Context, checks []AccessCheckInput, userId *types.UserIdScalar) ([]AccessCheckResult, error) {
+	resp, err := accessCheck(ctx, client.Gql, checks, userId)
+	if err != nil {
+		return nil, err
+	}
+	return resp.AccessCheck, nil
+}
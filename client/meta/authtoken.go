@@ -0,0 +1,53 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+type authtokenResponse interface {
+	GetAuthtoken() Authtoken
+}
+
+func authtokenOrError(r authtokenResponse, err error) (*Authtoken, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetAuthtoken()
+	return &result, nil
+}
+
+func (client *Client) GetAuthtoken(ctx context.Context, id string) (*Authtoken, error) {
+	resp, err := getAuthtoken(ctx, client.Gql, id)
+	return authtokenOrError(resp, err)
+}
+
+// CreateAuthtoken creates an API token, returning it along with its secret.
+// If replaceTokenId is set, that token is deleted once the new one has been
+// created.
+func (client *Client) CreateAuthtoken(ctx context.Context, input *AuthtokenInput, owningUser *types.UserIdScalar, replaceTokenId *string) (*Authtoken, string, error) {
+	resp, err := createAuthtoken(ctx, client.Gql, *input, nil, owningUser, replaceTokenId)
+	if err != nil {
+		return nil, "", err
+	}
+	return &resp.Result.Authtoken, resp.Result.Secret, nil
+}
+
+func (client *Client) UpdateAuthtoken(ctx context.Context, id string, input *AuthtokenInput, newOwningUser *types.UserIdScalar) (*Authtoken, error) {
+	resp, err := updateAuthtoken(ctx, client.Gql, id, *input, newOwningUser)
+	return authtokenOrError(resp, err)
+}
+
+func (client *Client) DeleteAuthtoken(ctx context.Context, id string) error {
+	resp, err := deleteAuthtoken(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) SearchAuthtokens(ctx context.Context, kinds []AuthtokenKind, user *types.UserIdScalar) ([]Authtoken, error) {
+	resp, err := searchAuthtokens(ctx, client.Gql, kinds, user)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Authtokens, nil
+}
@@ -0,0 +1,107 @@
+package meta
+
+import (
+	"context"
+)
+
+// The Get*Content methods return nil if the content is not installed.
+
+func (client *Client) GetKubernetesContent(ctx context.Context) (*KubernetesContent, error) {
+	resp, err := getKubernetesContent(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Content, nil
+}
+
+func (client *Client) UpdateKubernetesContent(ctx context.Context, input *KubernetesContentInput, action *RematerializationAction) (*KubernetesContent, error) {
+	resp, err := updateKubernetesContent(ctx, client.Gql, *input, action)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Content, nil
+}
+
+func (client *Client) DeleteKubernetesContent(ctx context.Context) error {
+	resp, err := deleteKubernetesContent(ctx, client.Gql)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) GetHostExplorerContent(ctx context.Context) (*HostExplorerContent, error) {
+	resp, err := getHostExplorerContent(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Content, nil
+}
+
+func (client *Client) UpdateHostExplorerContent(ctx context.Context, input *HostExplorerContentInput) (*HostExplorerContent, error) {
+	resp, err := updateHostExplorerContent(ctx, client.Gql, *input)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Content, nil
+}
+
+func (client *Client) DeleteHostExplorerContent(ctx context.Context) error {
+	resp, err := deleteHostExplorerContent(ctx, client.Gql)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) GetTracingContent(ctx context.Context) (*TracingContent, error) {
+	resp, err := getTracingContent(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Content, nil
+}
+
+// InstallTracingContent installs the tracing content on the default tracing
+// datasets if input is nil.
+func (client *Client) InstallTracingContent(ctx context.Context, input *TracingContentInput) (*TracingContent, error) {
+	resp, err := installTracingContent(ctx, client.Gql, input)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Content, nil
+}
+
+func (client *Client) GetLlmContent(ctx context.Context) (*LlmContent, error) {
+	resp, err := getLlmContent(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Content, nil
+}
+
+// InstallLlmContent installs the LLM content on the default tracing datasets
+// if input is nil.
+func (client *Client) InstallLlmContent(ctx context.Context, input *LlmContentInput) (*LlmContent, error) {
+	resp, err := installLlmContent(ctx, client.Gql, input)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Content, nil
+}
+
+func (client *Client) GetMonitoringContent(ctx context.Context) (*MonitoringContent, error) {
+	resp, err := getMonitoringContent(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Content, nil
+}
+
+// InstallMonitoringContent auto-discovers the System dataset if input is nil.
+func (client *Client) InstallMonitoringContent(ctx context.Context, input *MonitoringContentInput) (*MonitoringContent, error) {
+	resp, err := installMonitoringContent(ctx, client.Gql, input)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Content, nil
+}
+
+func (client *Client) DeleteMonitoringContent(ctx context.Context) error {
+	resp, err := deleteMonitoringContent(ctx, client.Gql)
+	return resultStatusError(resp, err)
+}
@@ -0,0 +1,47 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+)
+
+var errNoCurrentCustomer = fmt.Errorf("no current customer")
+
+func (client *Client) GetCurrentCustomerSso(ctx context.Context) (*CustomerSso, error) {
+	resp, err := getCurrentCustomerSso(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Customer == nil {
+		return nil, errNoCurrentCustomer
+	}
+	return &resp.Customer.Sso, nil
+}
+
+func (client *Client) UpdateCurrentCustomerSso(ctx context.Context, input *CustomerSsoInput) (*CustomerSso, error) {
+	resp, err := updateCurrentCustomerSso(ctx, client.Gql, *input)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Sso, nil
+}
+
+// GetPasswordPolicy returns nil if no password policy has been set.
+func (client *Client) GetPasswordPolicy(ctx context.Context) (*PasswordPolicy, error) {
+	resp, err := getPasswordPolicy(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Customer == nil {
+		return nil, errNoCurrentCustomer
+	}
+	return resp.Customer.PasswordPolicy, nil
+}
+
+func (client *Client) UpdatePasswordPolicy(ctx context.Context, input *PasswordPolicyInput) (*PasswordPolicy, error) {
+	resp, err := updatePasswordPolicy(ctx, client.Gql, *input)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.PasswordPolicy, nil
+}
@@ -0,0 +1,67 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type dataExportJobResponse interface {
+	GetDataExportJob() DataExportJob
+}
+
+func dataExportJobOrError(r dataExportJobResponse, err error) (*DataExportJob, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetDataExportJob()
+	return &result, nil
+}
+
+func (client *Client) GetDataExportJob(ctx context.Context, id string) (*DataExportJob, error) {
+	resp, err := getDataExportJob(ctx, client.Gql, id)
+	return dataExportJobOrError(resp, err)
+}
+
+func (client *Client) CreateDataExportJob(ctx context.Context, workspaceId string, input *DataExportJobInput) (*DataExportJob, error) {
+	resp, err := createDataExportJob(ctx, client.Gql, workspaceId, *input)
+	return dataExportJobOrError(resp, err)
+}
+
+func (client *Client) UpdateDataExportJob(ctx context.Context, id string, input *DataExportJobInput) (*DataExportJob, error) {
+	resp, err := updateDataExportJob(ctx, client.Gql, id, *input)
+	return dataExportJobOrError(resp, err)
+}
+
+func (client *Client) DeleteDataExportJob(ctx context.Context, id string) error {
+	resp, err := deleteDataExportJob(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) RetryDataExportJob(ctx context.Context, id string) (*DataExportJob, error) {
+	resp, err := retryDataExportJob(ctx, client.Gql, id)
+	return dataExportJobOrError(resp, err)
+}
+
+func (client *Client) SearchDataExportJob(ctx context.Context, workspaceId *string, nameExact *string) ([]DataExportJob, error) {
+	resp, err := searchDataExportJob(ctx, client.Gql, workspaceId, nil, nameExact, nil)
+	if err != nil || resp == nil {
+		return nil, err
+	}
+	return resp.DataExportJobs.Results, nil
+}
+
+func (client *Client) GetDataExportDestinationConfig(ctx context.Context, workspaceId *string) (*DataExportDestinationConfig, error) {
+	resp, err := getDataExportDestinationConfig(ctx, client.Gql, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.DataExportDestinationConfig, nil
+}
+
+func (j *DataExportJob) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   j.Id,
+		Type: oid.TypeDataExportJob,
+	}
+}
@@ -0,0 +1,62 @@
+package meta
+
+import (
+	"context"
+)
+
+// ListDatasetLineage returns every visible dataset along with the ids of the
+// datasets it reads from or links to. Downstream dependents are not exposed
+// by the API, so callers must list all datasets to invert the graph.
+func (client *Client) ListDatasetLineage(ctx context.Context) ([]DatasetLineageNode, error) {
+	resp, err := listDatasetLineage(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]DatasetLineageNode, 0, len(resp.Datasets))
+	for _, match := range resp.Datasets {
+		result = append(result, match.Dataset)
+	}
+	return result, nil
+}
+
+// ListMonitorV2Lineage returns every visible monitor along with the datasets
+// it reads from.
+func (client *Client) ListMonitorV2Lineage(ctx context.Context) ([]MonitorV2LineageNode, error) {
+	resp, err := listMonitorV2Lineage(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	return resp.MonitorV2s.Results, nil
+}
+
+// InputIds returns the sorted ids of all datasets the dataset reads from.
+func (d *DatasetLineageNode) InputIds() []string {
+	ids := make([]*string, 0, len(d.Inputs))
+	for i := range d.Inputs {
+		ids = append(ids, &d.Inputs[i].DatasetId)
+	}
+	return uniqueSortedIds(ids)
+}
+
+// ForeignKeyIds returns the sorted ids of all datasets the dataset links to.
+func (d *DatasetLineageNode) ForeignKeyIds() []string {
+	ids := make([]*string, 0, len(d.ForeignKeys))
+	for _, fk := range d.ForeignKeys {
+		if fk.TargetDataset != nil {
+			id := fk.TargetDataset.String()
+			ids = append(ids, &id)
+		}
+	}
+	return uniqueSortedIds(ids)
+}
+
+// DatasetIds returns the sorted ids of all datasets the monitor reads from.
+func (m *MonitorV2LineageNode) DatasetIds() []string {
+	var ids []*string
+	for _, stage := range m.Definition.InputQuery.Stages {
+		for _, input := range stage.Input {
+			ids = append(ids, input.DatasetId)
+		}
+	}
+	return uniqueSortedIds(ids)
+}
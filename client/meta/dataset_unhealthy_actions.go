@@ -0,0 +1,54 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+func (client *Client) GetDatasetUnhealthyActions(ctx context.Context, datasetId string) ([]string, error) {
+	resp, err := getDatasetUnhealthyActions(ctx, client.Gql, datasetId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Dataset == nil {
+		return nil, objectNotFoundError(oid.DatasetOid(datasetId))
+	}
+	return resp.Dataset.OnUnhealthyActionIds, nil
+}
+
+// SetDatasetUnhealthyActions replaces the full set of actions bound to the
+// dataset. An empty list clears all bindings.
+func (client *Client) SetDatasetUnhealthyActions(ctx context.Context, datasetId string, actionIds []string) ([]string, error) {
+	resp, err := setDatasetUnhealthyActions(ctx, client.Gql, datasetId, actionIds)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Dataset.OnUnhealthyActionIds, nil
+}
+
+func (client *Client) GetDefaultDatasetUnhealthyActions(ctx context.Context) ([]string, error) {
+	resp, err := getDefaultDatasetUnhealthyActions(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	actionIds := make([]string, 0, len(resp.Actions))
+	for _, action := range resp.Actions {
+		actionIds = append(actionIds, action.Id)
+	}
+	return actionIds, nil
+}
+
+// SetDefaultDatasetUnhealthyActions replaces the customer-wide default actions
+// fired for datasets without their own bindings. An empty list clears them.
+func (client *Client) SetDefaultDatasetUnhealthyActions(ctx context.Context, actionIds []string) ([]string, error) {
+	resp, err := setDefaultDatasetUnhealthyActions(ctx, client.Gql, actionIds)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(resp.Actions))
+	for _, action := range resp.Actions {
+		result = append(result, action.Id)
+	}
+	return result, nil
+}
@@ -0,0 +1,130 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type dataConnectionResponse interface {
+	GetDataConnection() DataConnection
+}
+
+func dataConnectionOrError(r dataConnectionResponse, err error) (*DataConnection, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetDataConnection()
+	return &result, nil
+}
+
+func (client *Client) GetDataConnection(ctx context.Context, id string) (*DataConnection, error) {
+	resp, err := getDataConnection(ctx, client.Gql, id)
+	return dataConnectionOrError(resp, err)
+}
+
+func (client *Client) CreateDataConnection(ctx context.Context, workspaceId string, input *DataConnectionInput) (*DataConnection, error) {
+	resp, err := createDataConnection(ctx, client.Gql, workspaceId, *input)
+	return dataConnectionOrError(resp, err)
+}
+
+func (client *Client) UpdateDataConnection(ctx context.Context, id string, input *DataConnectionInput) (*DataConnection, error) {
+	resp, err := updateDataConnection(ctx, client.Gql, id, *input)
+	return dataConnectionOrError(resp, err)
+}
+
+func (client *Client) DeleteDataConnection(ctx context.Context, id string) error {
+	resp, err := deleteDataConnection(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) SearchDataConnection(ctx context.Context, workspaceId *string, nameExact *string) ([]DataConnection, error) {
+	resp, err := searchDataConnection(ctx, client.Gql, workspaceId, nil, nameExact, nil)
+	if err != nil || resp == nil {
+		return nil, err
+	}
+	return resp.DataConnections.Results, nil
+}
+
+// GetLatestDataConnectionModuleVersion returns the most recent version of a
+// data connection module.
+func (client *Client) GetLatestDataConnectionModuleVersion(ctx context.Context, workspaceId *string, moduleId string) (string, error) {
+	latest := true
+	resp, err := getDataConnectionModuleVersions(ctx, client.Gql, moduleId, workspaceId, &latest)
+	if err != nil {
+		return "", err
+	}
+	if len(resp.ModuleVersions) == 0 {
+		return "", fmt.Errorf("no versions found for data connection module %q", moduleId)
+	}
+	return resp.ModuleVersions[0].Version, nil
+}
+
+func (c *DataConnection) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   c.Id,
+		Type: oid.TypeDataConnection,
+	}
+}
+
+type datasourceResponse interface {
+	GetDatasource() Datasource
+}
+
+func datasourceOrError(r datasourceResponse, err error) (*Datasource, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetDatasource()
+	return &result, nil
+}
+
+func (client *Client) GetDatasource(ctx context.Context, id string) (*Datasource, error) {
+	resp, err := getDatasource(ctx, client.Gql, id)
+	return datasourceOrError(resp, err)
+}
+
+func (client *Client) CreateDatasource(ctx context.Context, workspaceId string, input *DatasourceInput) (*Datasource, error) {
+	resp, err := createDatasource(ctx, client.Gql, workspaceId, *input)
+	return datasourceOrError(resp, err)
+}
+
+func (client *Client) UpdateDatasource(ctx context.Context, id string, input *DatasourceInput) (*Datasource, error) {
+	resp, err := updateDatasource(ctx, client.Gql, id, *input)
+	return datasourceOrError(resp, err)
+}
+
+func (client *Client) DeleteDatasource(ctx context.Context, id string) error {
+	resp, err := deleteDatasource(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) SearchDatasource(ctx context.Context, workspaceId *string, nameExact *string) ([]Datasource, error) {
+	resp, err := searchDatasource(ctx, client.Gql, workspaceId, nil, nameExact, nil)
+	if err != nil || resp == nil {
+		return nil, err
+	}
+	return resp.Datasources.Results, nil
+}
+
+func (client *Client) GetDatasourcesByConnection(ctx context.Context, dataConnectionId string) ([]Datasource, error) {
+	resp, err := getDatasourcesByConnection(ctx, client.Gql, dataConnectionId)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Datasource, 0, len(resp.Datasources))
+	for _, datasource := range resp.Datasources {
+		if datasource != nil {
+			result = append(result, *datasource)
+		}
+	}
+	return result, nil
+}
+
+func (d *Datasource) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   d.Id,
+		Type: oid.TypeDatasource,
+	}
+}
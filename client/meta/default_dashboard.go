@@ -21,3 +21,42 @@ func (client *Client) ClearDefaultDashboard(ctx context.Context, id string) erro
 	}
 	return resultStatusError(resp, err)
 }
+
+func (client *Client) SetDefaultInstanceDashboard(ctx context.Context, dsid string, dashid string) error {
+	resp, err := setDefaultInstanceDashboard(ctx, client.Gql, dsid, dashid)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) GetDefaultInstanceDashboard(ctx context.Context, id string) (*string, error) {
+	resp, err := getDefaultInstanceDashboard(ctx, client.Gql, id)
+	if err != nil {
+		return nil, err
+	}
+	return resp.DefaultInstanceDashboard, nil
+}
+
+func (client *Client) ClearDefaultInstanceDashboard(ctx context.Context, id string) error {
+	resp, err := clearDefaultInstanceDashboard(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) SetDefaultDashboardForTag(ctx context.Context, tag string, dashid string) error {
+	resp, err := setDefaultDashboardForTag(ctx, client.Gql, tag, dashid)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) GetDefaultDashboardForTag(ctx context.Context, tag string) (*string, error) {
+	resp, err := getDefaultDashboardForTag(ctx, client.Gql, tag)
+	if err != nil {
+		return nil, err
+	}
+	return resp.DefaultDashboardForTag, nil
+}
+
+func (client *Client) GetTagsDashboardIsDefaultFor(ctx context.Context, dashid string) ([]string, error) {
+	resp, err := getTagsDashboardIsDefaultFor(ctx, client.Gql, dashid)
+	if err != nil {
+		return nil, err
+	}
+	return resp.TagsDashboardIsDefaultFor, nil
+}
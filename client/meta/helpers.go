@@ -189,6 +189,11 @@ var AllMonitorV2ActionTypes = []MonitorV2ActionType{
 	MonitorV2ActionTypeWebhook,
 }
 
+var AllMonitorV2AiTriagingModes = []MonitorV2AiTriagingMode{
+	MonitorV2AiTriagingModeNone,
+	MonitorV2AiTriagingModeTriage,
+}
+
 var AllMonitorV2HttpTypes = []MonitorV2HttpType{
 	MonitorV2HttpTypePost,
 	MonitorV2HttpTypePut,
@@ -231,6 +236,140 @@ var AllLogDerivedMetricAggregationFunctions = []LogDerivedMetricAggregationFunct
 	LogDerivedMetricAggregationFunctionMax,
 }
 
+var AllDataExportJobTypes = []DataExportJobType{
+	DataExportJobTypeCustom,
+	DataExportJobTypeLive,
+	DataExportJobTypeRetention,
+}
+
+var AllDataExportJobFormats = []DataExportJobFormat{
+	DataExportJobFormatJson,
+	DataExportJobFormatParquet,
+}
+
+var AllNotebookQueryRenderTypes = []NotebookQueryRenderType{
+	NotebookQueryRenderTypeKubernetesclusterlist,
+	NotebookQueryRenderTypeKubernetesconfigmaplist,
+	NotebookQueryRenderTypeKubernetescronjoblist,
+	NotebookQueryRenderTypeKubernetesdaemonsetlist,
+	NotebookQueryRenderTypeKubernetesdeploymentlist,
+	NotebookQueryRenderTypeKubernetesingresslist,
+	NotebookQueryRenderTypeKubernetesjoblist,
+	NotebookQueryRenderTypeKubernetesnamespacelist,
+	NotebookQueryRenderTypeKubernetesnodelist,
+	NotebookQueryRenderTypeKubernetespersistentvolumeclaimlist,
+	NotebookQueryRenderTypeKubernetespersistentvolumelist,
+	NotebookQueryRenderTypeKubernetespodlist,
+	NotebookQueryRenderTypeKubernetesreplicasetlist,
+	NotebookQueryRenderTypeKubernetessecretlist,
+	NotebookQueryRenderTypeKubernetesservicelist,
+	NotebookQueryRenderTypeKubernetesstatefulsetlist,
+	NotebookQueryRenderTypeServicelist,
+	NotebookQueryRenderTypeTraceflamechart,
+}
+
+var AllIncidentStatuses = []IncidentStatus{
+	IncidentStatusActive,
+	IncidentStatusInactive,
+	IncidentStatusClosed,
+}
+
+var AllDatasourceTypes = []DatasourceType{
+	DatasourceTypeFiledrop,
+	DatasourceTypePoller,
+	DatasourceTypeToken,
+}
+
+var AllAuthtokenKinds = []AuthtokenKind{
+	AuthtokenKindDatastream,
+	AuthtokenKindLogin,
+	AuthtokenKindApi,
+	AuthtokenKindSso,
+}
+
+var AllAccelerationJobStates = []AccelerationJobState{
+	AccelerationJobStateRunning,
+	AccelerationJobStateCompleted,
+	AccelerationJobStateCancelled,
+}
+
+var AllConfidenceCostEstimates = []ConfidenceCostEstimate{
+	ConfidenceCostEstimateVerylow,
+	ConfidenceCostEstimateLow,
+	ConfidenceCostEstimateMedium,
+	ConfidenceCostEstimateHigh,
+}
+
+var AllAccessActions = []AccessAction{
+	AccessActionAdminworkspace,
+	AccessActionAichatcreate,
+	AccessActionAichatedit,
+	AccessActionAichatview,
+	AccessActionApitokencreate,
+	AccessActionBookmarkmanage,
+	AccessActionDashboardcreate,
+	AccessActionDashboardedit,
+	AccessActionDashboardeditvisibility,
+	AccessActionDashboardview,
+	AccessActionDatasetaccelerate,
+	AccessActionDatasetcreate,
+	AccessActionDatasetedit,
+	AccessActionDatasetview,
+	AccessActionDatastreamcreate,
+	AccessActionDatastreamedit,
+	AccessActionDatastreamview,
+	AccessActionIcebergcatalogview,
+	AccessActionInvestigatorglobal,
+	AccessActionMonitorcreate,
+	AccessActionMonitoredit,
+	AccessActionMonitorglobalmute,
+	AccessActionMonitorview,
+	AccessActionMonitoractioncreate,
+	AccessActionReferencetablecreate,
+	AccessActionReportmanage,
+	AccessActionServiceaccountcreate,
+	AccessActionShareinmanage,
+	AccessActionShareinview,
+	AccessActionSkilleditvisibility,
+	AccessActionStorageintegrationusage,
+	AccessActionUserdelete,
+	AccessActionUserinvite,
+	AccessActionWorksheetcreate,
+	AccessActionWorksheetedit,
+	AccessActionWorksheeteditvisibility,
+	AccessActionWorksheetview,
+}
+
+var AllRematerializationActions = []RematerializationAction{
+	RematerializationActionAbortonrematerialization,
+	RematerializationActionAbortoncontentrematerialization,
+	RematerializationActionIgnorerematerialization,
+}
+
+var AllDatasetKinds = []DatasetKind{
+	DatasetKindTable,
+	DatasetKindResource,
+	DatasetKindEvent,
+	DatasetKindInterval,
+}
+
+var AllSearchModes = []SearchMode{
+	SearchModeInclusivemode,
+	SearchModeExclusivemode,
+}
+
+var AllMetricStates = []MetricState{
+	MetricStateActive,
+	MetricStateInactive,
+	MetricStateError,
+}
+
+var AllTagKinds = []TagKind{
+	TagKindIngest,
+	TagKindCorrelation,
+	TagKindMetric,
+}
+
 const (
 	ErrNotFound = "NOT_FOUND"
 )
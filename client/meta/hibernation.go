@@ -0,0 +1,31 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+// HibernationAction hibernates or wakes up a dataset. When dryRun is set, the
+// datasets which would be affected are returned without applying the action.
+func (client *Client) HibernationAction(ctx context.Context, datasetId string, action HibernationActionType, dryRun bool) (*HibernationActionResponse, error) {
+	resp, err := hibernationAction(ctx, client.Gql, datasetId, action, &dryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Response, nil
+}
+
+// GetDatasetHibernatedAt returns when the dataset was hibernated, or nil if it
+// is not hibernated.
+func (client *Client) GetDatasetHibernatedAt(ctx context.Context, datasetId string) (*types.TimeScalar, error) {
+	resp, err := getDatasetHibernation(ctx, client.Gql, datasetId)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Dataset == nil {
+		return nil, objectNotFoundError(oid.DatasetOid(datasetId))
+	}
+	return resp.Dataset.HibernatedAt, nil
+}
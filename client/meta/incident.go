@@ -0,0 +1,64 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type incidentResponse interface {
+	GetIncident() Incident
+}
+
+func incidentOrError(r incidentResponse, err error) (*Incident, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetIncident()
+	return &result, nil
+}
+
+func (client *Client) GetIncident(ctx context.Context, id string) (*Incident, error) {
+	resp, err := getIncident(ctx, client.Gql, id)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) CreateIncident(ctx context.Context, workspaceId string, input *IncidentInput) (*Incident, error) {
+	resp, err := createIncident(ctx, client.Gql, workspaceId, *input)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) UpdateIncident(ctx context.Context, id string, input *IncidentInput) (*Incident, error) {
+	resp, err := updateIncident(ctx, client.Gql, id, *input)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) DeleteIncident(ctx context.Context, id string) error {
+	resp, err := deleteIncident(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) AddIncidentSlackChannels(ctx context.Context, id string, channels []IncidentSlackchannelInput) (*Incident, error) {
+	resp, err := addIncidentSlackChannels(ctx, client.Gql, id, channels)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) RemoveIncidentSlackChannels(ctx context.Context, id string, channels []IncidentSlackchannelInput) (*Incident, error) {
+	resp, err := removeIncidentSlackChannels(ctx, client.Gql, id, channels)
+	return incidentOrError(resp, err)
+}
+
+func (client *Client) SearchIncident(ctx context.Context, workspaceId *string, nameExact *string) ([]Incident, error) {
+	resp, err := searchIncident(ctx, client.Gql, workspaceId, nil, nameExact, nil)
+	if err != nil || resp == nil {
+		return nil, err
+	}
+	return resp.Incidents.Results, nil
+}
+
+func (i *Incident) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   i.Id,
+		Type: oid.TypeIncident,
+	}
+}
@@ -37,3 +37,12 @@ func (c *Client) DeleteIngestToken(
 	response, err := deleteIngestToken(ctx, c.Gql, id)
 	return resultStatusError(response, err)
 }
+
+func (c *Client) UpdateIngestTokenAssociation(
+	ctx context.Context,
+	id string,
+	datastreamIds []string,
+) error {
+	response, err := updateIngestTokenAssociation(ctx, c.Gql, id, datastreamIds)
+	return resultStatusError(response, err)
+}
@@ -0,0 +1,57 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+// MetricSearchFilter holds the arguments to metricSearch. Unset fields do
+// not restrict the search.
+type MetricSearchFilter struct {
+	Workspaces            []string
+	InDatasets            []string
+	CorrelationTagMatches []string
+	Match                 string
+}
+
+// MetricTagValuesFilter holds the arguments to searchMetricTagValues.
+type MetricTagValuesFilter struct {
+	Tag        string
+	Metrics    []string
+	DatasetIds []string
+	Kinds      []TagKind
+	Prefix     *string
+	Limit      types.Int64Scalar
+}
+
+// SearchMetrics returns the metrics matching filter.
+func (client *Client) SearchMetrics(ctx context.Context, filter MetricSearchFilter) (*MetricSearchResult, error) {
+	resp, err := metricSearch(ctx, client.Gql,
+		filter.Workspaces,
+		filter.InDatasets,
+		filter.CorrelationTagMatches,
+		filter.Match,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Result, nil
+}
+
+// SearchMetricTagValues returns the values seen for a metric tag, sorted
+// alphabetically.
+func (client *Client) SearchMetricTagValues(ctx context.Context, filter MetricTagValuesFilter) (*MetricTagValues, error) {
+	resp, err := searchMetricTagValues(ctx, client.Gql,
+		filter.Tag,
+		filter.Metrics,
+		filter.DatasetIds,
+		filter.Kinds,
+		filter.Prefix,
+		filter.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Result, nil
+}
@@ -62,6 +62,15 @@ func (client *Client) LookupMonitorV2(ctx context.Context, workspaceId *string,
 	return &resp.MonitorV2s.Results[0], nil
 }
 
+// SearchMonitorV2s returns all monitors matching the provided filters.
+func (client *Client) SearchMonitorV2s(ctx context.Context, workspaceId *string, folderId *string, nameSubstring *string) ([]MonitorV2, error) {
+	resp, err := lookupMonitorV2(ctx, client.Gql, workspaceId, folderId, nil, nameSubstring)
+	if err != nil {
+		return nil, err
+	}
+	return resp.MonitorV2s.Results, nil
+}
+
 func (m *MonitorV2) Oid() *oid.OID {
 	return &oid.OID{
 		Id:   m.Id,
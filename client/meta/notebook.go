@@ -0,0 +1,54 @@
+package meta
+
+import (
+	"context"
+
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+)
+
+type investigationNotebookResponse interface {
+	GetInvestigationNotebook() InvestigationNotebook
+}
+
+func investigationNotebookOrError(r investigationNotebookResponse, err error) (*InvestigationNotebook, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := r.GetInvestigationNotebook()
+	return &result, nil
+}
+
+func (client *Client) GetInvestigationNotebook(ctx context.Context, id string) (*InvestigationNotebook, error) {
+	resp, err := getInvestigationNotebook(ctx, client.Gql, id)
+	return investigationNotebookOrError(resp, err)
+}
+
+func (client *Client) CreateInvestigationNotebook(ctx context.Context, workspaceId string, input *InvestigationNotebookInput) (*InvestigationNotebook, error) {
+	resp, err := createInvestigationNotebook(ctx, client.Gql, workspaceId, *input)
+	return investigationNotebookOrError(resp, err)
+}
+
+func (client *Client) UpdateInvestigationNotebook(ctx context.Context, id string, input *InvestigationNotebookInput) (*InvestigationNotebook, error) {
+	resp, err := updateInvestigationNotebook(ctx, client.Gql, id, *input)
+	return investigationNotebookOrError(resp, err)
+}
+
+func (client *Client) DeleteInvestigationNotebook(ctx context.Context, id string) error {
+	resp, err := deleteInvestigationNotebook(ctx, client.Gql, id)
+	return resultStatusError(resp, err)
+}
+
+func (client *Client) SearchInvestigationNotebook(ctx context.Context, workspaceId *string, nameExact *string) ([]InvestigationNotebook, error) {
+	resp, err := searchInvestigationNotebook(ctx, client.Gql, workspaceId, nil, nameExact, nil)
+	if err != nil || resp == nil {
+		return nil, err
+	}
+	return resp.InvestigationNotebooks.Results, nil
+}
+
+func (n *InvestigationNotebook) Oid() *oid.OID {
+	return &oid.OID{
+		Id:   n.Id,
+		Type: oid.TypeInvestigationNotebook,
+	}
+}
@@ -0,0 +1,80 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	oid "github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// OwnableObjectTypes lists the object types whose owner can be changed.
+var OwnableObjectTypes = []oid.Type{
+	oid.TypeDataset,
+	oid.TypeDashboard,
+	oid.TypeWorksheet,
+	oid.TypeMonitorV2,
+	oid.TypeFolder,
+}
+
+func objectNotFoundError(id oid.OID) error {
+	return gqlerror.List{
+		&gqlerror.Error{
+			Message: fmt.Sprintf("%s not found", id.Type),
+			Extensions: map[string]interface{}{
+				"code": ErrNotFound,
+			},
+		},
+	}
+}
+
+// GetWorkspaceObjectOwner returns the owner of a workspace object, which is
+// the user it was created by unless ownership was transferred since.
+func (client *Client) GetWorkspaceObjectOwner(ctx context.Context, id oid.OID) (types.UserIdScalar, error) {
+	switch id.Type {
+	case oid.TypeDataset:
+		resp, err := getDatasetOwner(ctx, client.Gql, id.Id)
+		if err != nil {
+			return 0, err
+		}
+		if resp.Dataset == nil {
+			return 0, objectNotFoundError(id)
+		}
+		return resp.Dataset.CreatedBy, nil
+	case oid.TypeDashboard:
+		resp, err := getDashboardOwner(ctx, client.Gql, id.Id)
+		if err != nil {
+			return 0, err
+		}
+		return resp.Dashboard.CreatedBy, nil
+	case oid.TypeWorksheet:
+		resp, err := getWorksheetOwner(ctx, client.Gql, id.Id)
+		if err != nil {
+			return 0, err
+		}
+		if resp.Worksheet == nil {
+			return 0, objectNotFoundError(id)
+		}
+		return resp.Worksheet.CreatedBy, nil
+	case oid.TypeMonitorV2:
+		resp, err := getMonitorV2Owner(ctx, client.Gql, id.Id)
+		if err != nil {
+			return 0, err
+		}
+		return resp.MonitorV2.CreatedBy, nil
+	case oid.TypeFolder:
+		resp, err := getFolderOwner(ctx, client.Gql, id.Id)
+		if err != nil {
+			return 0, err
+		}
+		return resp.Folder.CreatedBy, nil
+	default:
+		return 0, fmt.Errorf("objects of type %q do not have an owner", id.Type)
+	}
+}
+
+func (client *Client) SetWorkspaceObjectOwner(ctx context.Context, id string, owner types.UserIdScalar) error {
+	resp, err := setWorkspaceObjectOwner(ctx, client.Gql, id, owner)
+	return resultStatusError(resp, err)
+}
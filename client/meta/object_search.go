@@ -0,0 +1,98 @@
+package meta
+
+import (
+	"context"
+	"sort"
+
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+// DatasetSearchFilter holds the arguments to datasetSearch. Unset fields do
+// not restrict the search.
+type DatasetSearchFilter struct {
+	Projects                []string
+	LabelMatches            []string
+	ColumnMatches           []string
+	KeyMatchTypes           []string
+	ForeignKeyTargetMatches []string
+	CorrelationTagMatches   []string
+	ImplementsInterfaces    []string
+	SourceMatches           []string
+	SearchMode              *SearchMode
+}
+
+// SearchDatasets returns the datasets matching filter, ordered by score.
+func (client *Client) SearchDatasets(ctx context.Context, filter DatasetSearchFilter) ([]DatasetMatch, error) {
+	resp, err := datasetSearch(ctx, client.Gql,
+		filter.Projects,
+		filter.LabelMatches,
+		filter.ColumnMatches,
+		filter.KeyMatchTypes,
+		filter.ForeignKeyTargetMatches,
+		filter.CorrelationTagMatches,
+		filter.ImplementsInterfaces,
+		filter.SourceMatches,
+		filter.SearchMode,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Datasets, nil
+}
+
+// SearchDashboards returns the dashboards matching terms.
+func (client *Client) SearchDashboards(ctx context.Context, terms DWSearchInput, maxCount *types.Int64Scalar) (*DashboardSearchResultWrapper, error) {
+	resp, err := dashboardSearch(ctx, client.Gql, terms, maxCount)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Result, nil
+}
+
+// SearchWorksheets returns the worksheets matching terms.
+func (client *Client) SearchWorksheets(ctx context.Context, terms DWSearchInput, maxCount *types.Int64Scalar) (*WorksheetSearchResultWrapper, error) {
+	resp, err := worksheetSearch(ctx, client.Gql, terms, maxCount)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Result, nil
+}
+
+// DatasetIds returns the sorted ids of all datasets the dashboard reads from.
+func (d *DashboardSearchMatchDashboard) DatasetIds() []string {
+	var ids []*string
+	for _, stage := range d.Stages {
+		for _, input := range stage.Input {
+			ids = append(ids, input.DatasetId)
+		}
+	}
+	return uniqueSortedIds(ids)
+}
+
+// DatasetIds returns the sorted ids of all datasets the worksheet reads from.
+func (w *WorksheetSearchMatchWorksheet) DatasetIds() []string {
+	var ids []*string
+	for _, stage := range w.Stages {
+		for _, input := range stage.Input {
+			ids = append(ids, input.DatasetId)
+		}
+	}
+	return uniqueSortedIds(ids)
+}
+
+func uniqueSortedIds(ids []*string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == nil || *id == "" {
+			continue
+		}
+		if _, ok := seen[*id]; ok {
+			continue
+		}
+		seen[*id] = struct{}{}
+		result = append(result, *id)
+	}
+	sort.Strings(result)
+	return result
+}
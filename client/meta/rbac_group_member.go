@@ -3,6 +3,7 @@ package meta
 import (
 	"context"
 
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	oid "github.com/observeinc/terraform-provider-observe/client/oid"
 )
 
@@ -33,6 +34,37 @@ func (client *Client) DeleteRbacGroupmember(ctx context.Context, id string) erro
 	return resultStatusError(resp, err)
 }
 
+// GetRbacGroupmembers returns the direct members of a group.
+func (client *Client) GetRbacGroupmembers(ctx context.Context, groupId string) ([]RbacGroupmember, error) {
+	resp, err := getRbacGroupmembers(ctx, client.Gql)
+	if err != nil {
+		return nil, err
+	}
+	var result []RbacGroupmember
+	for _, member := range resp.RbacGroupmembers {
+		if member.GroupId == groupId {
+			result = append(result, member)
+		}
+	}
+	return result, nil
+}
+
+// SetRbacGroupmembers replaces all direct members of a group.
+func (client *Client) SetRbacGroupmembers(ctx context.Context, groupId string, memberUsers []types.UserIdScalar, memberGroups []string) ([]RbacGroupmember, error) {
+	// an empty list removes all members, whereas null would be ignored
+	if memberUsers == nil {
+		memberUsers = []types.UserIdScalar{}
+	}
+	if memberGroups == nil {
+		memberGroups = []string{}
+	}
+	resp, err := setRbacGroupmembers(ctx, client.Gql, groupId, memberUsers, memberGroups)
+	if err != nil {
+		return nil, err
+	}
+	return resp.RbacGroupmembers, nil
+}
+
 func (r *RbacGroupmember) Oid() *oid.OID {
 	rbacGroupmemberOid := oid.RbacGroupmemberOid(r.Id)
 	return &rbacGroupmemberOid
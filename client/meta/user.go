@@ -56,6 +56,30 @@ func (client *Client) GetCurrentUser(ctx context.Context) (*User, error) {
 	return userOrError(resp, err)
 }
 
+// InviteUser invites a new user by email, returning the created user.
+func (client *Client) InviteUser(ctx context.Context, input *UserInput) (*User, error) {
+	if input.Email == nil {
+		return nil, fmt.Errorf("email is required to invite a user")
+	}
+	if _, err := inviteUser(ctx, client.Gql, *input); err != nil {
+		return nil, err
+	}
+	// the invite only returns the signup token, so look the user up by email
+	return client.LookupUser(ctx, *input.Email)
+}
+
+func (client *Client) UpdateUser(ctx context.Context, id string, input *UserInput) (*User, error) {
+	uid, err := types.StringToUserIdScalar(id)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := updateUser(ctx, client.Gql, uid, *input)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.User, nil
+}
+
 func (u *User) Oid() *oid.OID {
 	userOid := oid.UserOid(u.Id)
 	return &userOid
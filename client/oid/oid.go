@@ -42,6 +42,7 @@ const (
 	TypeMonitorV2               Type = "monitorv2"
 	TypeMonitorV2Action         Type = "monitorv2action"
 	TypeMonitorV2Destination    Type = "monitorv2destination"
+	TypeMonitorV2MuteRule       Type = "monitorv2muterule"
 	TypePoller                  Type = "poller"
 	TypePreferredPath           Type = "preferredpath"
 	TypeUser                    Type = "user"
@@ -61,6 +62,11 @@ const (
 	TypeInboundShare            Type = "inboundshare"
 	TypeInboundShareTable       Type = "inboundsharetable"
 	TypeSkill                   Type = "skill"
+	TypeDataExportJob           Type = "dataexportjob"
+	TypeInvestigationNotebook   Type = "investigationnotebook"
+	TypeIncident                Type = "incident"
+	TypeDataConnection          Type = "dataconnection"
+	TypeDatasource              Type = "datasource"
 )
 
 func (t Type) IsValid() bool {
@@ -88,6 +94,7 @@ func (t Type) IsValid() bool {
 	case TypeMonitorV2:
 	case TypeMonitorV2Action:
 	case TypeMonitorV2Destination:
+	case TypeMonitorV2MuteRule:
 	case TypePoller:
 	case TypePreferredPath:
 	case TypeUser:
@@ -107,6 +114,11 @@ func (t Type) IsValid() bool {
 	case TypeInboundShare:
 	case TypeInboundShareTable:
 	case TypeSkill:
+	case TypeDataExportJob:
+	case TypeInvestigationNotebook:
+	case TypeIncident:
+	case TypeDataConnection:
+	case TypeDatasource:
 	default:
 		return false
 	}
@@ -259,6 +271,10 @@ func MonitorV2ActionOid(id string) OID {
 	return OID{Id: id, Type: TypeMonitorV2Action}
 }
 
+func MonitorV2MuteRuleOid(id string) OID {
+	return OID{Id: id, Type: TypeMonitorV2MuteRule}
+}
+
 func PollerOid(id string) OID {
 	return OID{Id: id, Type: TypePoller}
 }
@@ -0,0 +1,35 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "production" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Production Logs"
+}
+
+data "observe_user" "contractor" {
+  email = "contractor@domain.com"
+}
+
+check "contractor_access" {
+  data "observe_access_check" "contractor" {
+    user = data.observe_user.contractor.oid
+
+    check {
+      action   = "dataset_view"
+      resource = data.observe_dataset.production.oid
+      expect   = "allowed"
+    }
+
+    check {
+      action   = "dataset_edit"
+      resource = data.observe_dataset.production.oid
+      expect   = "denied"
+    }
+  }
+
+  assert {
+    condition     = !data.observe_access_check.contractor.check[1].allowed
+    error_message = "Contractors must not be able to edit production datasets."
+  }
+}
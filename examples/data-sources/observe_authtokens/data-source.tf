@@ -0,0 +1,7 @@
+data "observe_authtokens" "ci" {
+  user = observe_service_account.ci.oid
+}
+
+output "ci_token_expirations" {
+  value = { for t in data.observe_authtokens.ci.authtokens : t.name => t.expiration }
+}
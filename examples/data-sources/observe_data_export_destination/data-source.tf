@@ -0,0 +1,5 @@
+data "observe_data_export_destination" "current" {}
+
+output "export_role" {
+  value = data.observe_data_export_destination.current.aws_iam_role
+}
@@ -0,0 +1,11 @@
+data "observe_dataset_acceleration_cost" "errors_backfill" {
+  dataset = observe_dataset.errors.oid
+
+  interval {
+    start = timeadd(plantimestamp(), "-168h")
+  }
+}
+
+output "errors_backfill_credits" {
+  value = data.observe_dataset_acceleration_cost.errors_backfill.additional_cost
+}
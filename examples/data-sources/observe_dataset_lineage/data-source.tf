@@ -0,0 +1,24 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "logs" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Kubernetes Explorer/Kubernetes Logs"
+}
+
+data "observe_dataset_lineage" "logs" {
+  dataset          = data.observe_dataset.logs.oid
+  upstream_depth   = 0
+  downstream_depth = 5
+}
+
+# everything that may break if the logs dataset changes
+output "impacted" {
+  value = {
+    for n in data.observe_dataset_lineage.logs.nodes : n.name => {
+      monitors   = n.monitors
+      dashboards = n.dashboards
+    } if n.downstream
+  }
+}
@@ -0,0 +1,20 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_folder" "services" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Services"
+}
+
+# every dataset in the folder with a service.name column
+data "observe_datasets" "services" {
+  workspace   = data.observe_workspace.default.oid
+  folder      = data.observe_folder.services.oid
+  columns     = ["service.name"]
+  search_mode = "exclusive_mode"
+}
+
+output "service_datasets" {
+  value = { for d in data.observe_datasets.services.datasets : d.name => d.oid }
+}
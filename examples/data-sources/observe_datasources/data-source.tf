@@ -0,0 +1,3 @@
+data "observe_datasources" "aws" {
+  data_connection = observe_data_connection.aws.oid
+}
@@ -0,0 +1,12 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "example" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Kubernetes/Pod"
+}
+
+data "observe_default_instance_dashboard" "example" {
+  dataset = data.observe_dataset.example.oid
+}
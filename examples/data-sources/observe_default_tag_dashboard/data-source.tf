@@ -0,0 +1,8 @@
+data "observe_default_tag_dashboard" "service" {
+  tag = "service.name"
+}
+
+# list all tags the service dashboard is the default for
+output "service_dashboard_tags" {
+  value = data.observe_default_tag_dashboard.service.tags
+}
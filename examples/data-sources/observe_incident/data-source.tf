@@ -0,0 +1,9 @@
+# lookup by id
+data "observe_incident" "id_lookup" {
+  id = 41000100
+}
+
+# lookup by name
+data "observe_incident" "name_lookup" {
+  name = "Q3 game day: ingest outage"
+}
@@ -0,0 +1,9 @@
+# lookup by id
+data "observe_investigation_notebook" "id_lookup" {
+  id = 41000100
+}
+
+# lookup by name
+data "observe_investigation_notebook" "name_lookup" {
+  name = "CrashLoopBackOff runbook"
+}
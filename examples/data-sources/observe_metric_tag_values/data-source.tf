@@ -0,0 +1,6 @@
+data "observe_metric_tag_values" "clusters" {
+  tag     = "k8s.cluster.name"
+  metrics = ["k8s_pod_cpu_usage"]
+  prefix  = "prod-"
+  limit   = 50
+}
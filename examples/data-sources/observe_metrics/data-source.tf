@@ -0,0 +1,13 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_metrics" "http" {
+  workspace = data.observe_workspace.default.oid
+  match     = "http_server_"
+}
+
+# metrics matching the prefix, e.g. to generate a monitor per metric
+output "http_metrics" {
+  value = [for m in data.observe_metrics.http.metrics : m.name if startswith(m.name, "http_server_")]
+}
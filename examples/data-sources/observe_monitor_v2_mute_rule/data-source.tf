@@ -0,0 +1,9 @@
+# lookup by id
+data "observe_monitor_v2_mute_rule" "id_lookup" {
+  id = 41000100
+}
+
+# lookup by name
+data "observe_monitor_v2_mute_rule" "name_lookup" {
+  name = "weekly staging deploy"
+}
@@ -0,0 +1,12 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_monitors_v2" "all" {
+  workspace = data.observe_workspace.default.oid
+}
+
+# monitors that notify nobody
+output "monitors_without_actions" {
+  value = [for m in data.observe_monitors_v2.all.monitors : m.name if length(m.actions) == 0]
+}
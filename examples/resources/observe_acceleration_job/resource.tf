@@ -0,0 +1,24 @@
+resource "time_static" "backfill_start" {
+  triggers = {
+    dataset = observe_dataset.errors.oid
+  }
+}
+
+resource "observe_acceleration_job" "errors_backfill" {
+  context = "backfill errors after pipeline change"
+
+  request {
+    # the dataset oid carries its version, so any change to the dataset
+    # starts a new backfill
+    dataset = observe_dataset.errors.oid
+
+    interval {
+      start = timeadd(time_static.backfill_start.rfc3339, "-168h")
+      end   = time_static.backfill_start.rfc3339
+    }
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
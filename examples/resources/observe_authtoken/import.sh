@@ -0,0 +1 @@
+terraform import observe_authtoken.example 7HZ4Q2K1X8
@@ -0,0 +1,16 @@
+resource "observe_service_account" "ci" {
+  label = "CI pipeline"
+}
+
+resource "time_rotating" "ci" {
+  rotation_days = 30
+}
+
+resource "observe_authtoken" "ci" {
+  name       = "CI pipeline"
+  user       = observe_service_account.ci.oid
+  expiration = timeadd(time_rotating.ci.rfc3339, "1080h")
+
+  # creates a replacement token before deleting the current one
+  rotation_trigger = time_rotating.ci.id
+}
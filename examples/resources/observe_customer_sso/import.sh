@@ -0,0 +1,2 @@
+# There is a single set of SSO settings per customer, imported using a constant ID
+terraform import observe_customer_sso.example customer_sso
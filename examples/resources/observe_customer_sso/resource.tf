@@ -0,0 +1,6 @@
+resource "observe_customer_sso" "example" {
+  saml_url            = "https://idp.example.com/app/observe/sso/saml/metadata"
+  saml_cert           = file("${path.module}/idp.pem")
+  scim_enabled        = true
+  local_login_enabled = false
+}
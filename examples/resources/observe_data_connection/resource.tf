@@ -0,0 +1,7 @@
+resource "observe_data_connection" "aws" {
+  name      = "Production AWS"
+  module_id = "aws"
+
+  # omit to pin the latest module version at creation
+  version = "1.2.0"
+}
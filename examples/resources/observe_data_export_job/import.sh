@@ -0,0 +1 @@
+terraform import observe_data_export_job.example 1414010
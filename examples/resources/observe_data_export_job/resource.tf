@@ -0,0 +1,26 @@
+data "observe_dataset" "audit" {
+  name = "Audit Log"
+}
+
+data "observe_data_export_destination" "current" {}
+
+# the bucket must be in data.observe_data_export_destination.current.aws_region,
+# and allow data.observe_data_export_destination.current.aws_iam_role to write to it.
+resource "observe_data_export_job" "archive" {
+  name        = "audit log archive"
+  dataset     = data.observe_dataset.audit.oid
+  type        = "retention"
+  format      = "parquet"
+  destination = "s3://my-compliance-bucket/audit-log"
+}
+
+# export a fixed starting point, once data is at least 6 hours old
+resource "observe_data_export_job" "backfill" {
+  name               = "audit log backfill"
+  dataset            = data.observe_dataset.audit.oid
+  type               = "custom"
+  format             = "json"
+  destination        = "s3://my-compliance-bucket/audit-log-backfill"
+  earliest_timestamp = "2024-01-01T00:00:00Z"
+  older_than         = "6h"
+}
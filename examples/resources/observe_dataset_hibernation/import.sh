@@ -0,0 +1 @@
+terraform import observe_dataset_hibernation.example 41000100
@@ -0,0 +1,6 @@
+resource "observe_dataset_hibernation" "holiday_orders" {
+  dataset = observe_dataset.holiday_orders.oid
+
+  # only keep the dataset awake during the fourth quarter
+  hibernated = !contains(["10", "11", "12"], formatdate("MM", plantimestamp()))
+}
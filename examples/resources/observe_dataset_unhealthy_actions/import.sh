@@ -0,0 +1 @@
+terraform import observe_dataset_unhealthy_actions.example 41000100
@@ -0,0 +1,4 @@
+resource "observe_dataset_unhealthy_actions" "checkout_errors" {
+  dataset = observe_dataset.checkout_errors.oid
+  actions = [observe_monitor_v2_action.payments_oncall.oid]
+}
@@ -0,0 +1 @@
+terraform import observe_datasource.example 1414010
@@ -0,0 +1,38 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+resource "observe_datastream" "aws" {
+  workspace = data.observe_workspace.default.oid
+  name      = "AWS"
+}
+
+resource "observe_data_connection" "aws" {
+  name      = "Production AWS"
+  module_id = "aws"
+}
+
+resource "observe_datasource" "cloudwatch" {
+  name            = "us-west-2 CloudWatch"
+  data_connection = observe_data_connection.aws.oid
+  datastream      = observe_datastream.aws.oid
+
+  client_stack_attributes = {
+    accountId = "123456789012"
+    region    = "us-west-2"
+  }
+
+  aws_metrics_poller {
+    interval = "5m"
+
+    metric {
+      namespace    = "AWS/EC2"
+      metric_names = ["CPUUtilization", "NetworkIn"]
+
+      tag_filter {
+        key    = "env"
+        values = ["production"]
+      }
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import observe_default_dataset_unhealthy_actions.default default_dataset_unhealthy_actions
@@ -0,0 +1,3 @@
+resource "observe_default_dataset_unhealthy_actions" "default" {
+  actions = [observe_monitor_v2_action.data_platform_oncall.oid]
+}
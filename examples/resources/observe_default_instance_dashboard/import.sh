@@ -0,0 +1,2 @@
+# Use the dataset ID to import the default instance dashboard
+terraform import observe_default_instance_dashboard.example 1414010
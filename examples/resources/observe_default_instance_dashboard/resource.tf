@@ -0,0 +1,18 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "example" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Kubernetes/Pod"
+}
+
+resource "observe_dashboard" "example" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Pod Details"
+}
+
+resource "observe_default_instance_dashboard" "example" {
+  dataset   = data.observe_dataset.example.oid
+  dashboard = observe_dashboard.example.oid
+}
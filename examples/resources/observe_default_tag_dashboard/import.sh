@@ -0,0 +1,2 @@
+# Use the correlation tag to import the default dashboard
+terraform import observe_default_tag_dashboard.example service.name
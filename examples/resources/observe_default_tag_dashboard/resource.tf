@@ -0,0 +1,13 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+resource "observe_dashboard" "example" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Service Overview"
+}
+
+resource "observe_default_tag_dashboard" "example" {
+  tag       = "service.name"
+  dashboard = observe_dashboard.example.oid
+}
@@ -0,0 +1,2 @@
+# There is a single host explorer content installation per customer, imported using a constant ID
+terraform import observe_host_explorer_content.example host_explorer_content
@@ -0,0 +1,12 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "otel_logs" {
+  workspace = data.observe_workspace.default.oid
+  name      = "OpenTelemetry/Logs"
+}
+
+resource "observe_host_explorer_content" "example" {
+  otel_logs_dataset = data.observe_dataset.otel_logs.oid
+}
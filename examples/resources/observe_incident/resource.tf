@@ -0,0 +1,9 @@
+resource "observe_incident" "game_day" {
+  name        = "Q3 game day: ingest outage"
+  description = "Simulated loss of the primary ingest pipeline"
+
+  slack_channel {
+    connection_id = "T0123456789"
+    channel_id    = "C0123456789"
+  }
+}
@@ -0,0 +1 @@
+terraform import observe_investigation_notebook.example 1414010
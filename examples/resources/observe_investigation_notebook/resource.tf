@@ -0,0 +1,41 @@
+data "observe_dataset" "kubernetes_logs" {
+  name = "Kubernetes Explorer/Kubernetes Logs"
+}
+
+data "observe_monitor_v2" "crashloop" {
+  name = "Pods in CrashLoopBackOff"
+}
+
+resource "observe_investigation_notebook" "crashloop" {
+  name        = "CrashLoopBackOff runbook"
+  description = "First steps when pods are crash looping"
+  notes       = ["Pods in the sandbox namespace can be ignored."]
+
+  trigger_context {
+    monitor = data.observe_monitor_v2.crashloop.oid
+  }
+
+  block {
+    markdown {
+      text = <<-EOF
+        # CrashLoopBackOff
+        Check the logs of the restarting container for errors before it exited.
+      EOF
+    }
+  }
+
+  block {
+    query {
+      inputs = {
+        "logs" = data.observe_dataset.kubernetes_logs.oid
+      }
+      stage {
+        pipeline = <<-EOF
+          filter stream = "stderr"
+          timechart 5m, count:count(), group_by(namespace, podName)
+        EOF
+      }
+      description = "stderr volume by pod"
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# There is a single kubernetes content installation per customer, imported using a constant ID
+terraform import observe_kubernetes_content.example kubernetes_content
@@ -0,0 +1,13 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_dataset" "prometheus" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Kubernetes Explorer/Prometheus Metrics"
+}
+
+resource "observe_kubernetes_content" "example" {
+  prometheus_dataset       = data.observe_dataset.prometheus.oid
+  rematerialization_action = "abort_on_content_rematerialization"
+}
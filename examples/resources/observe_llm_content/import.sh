@@ -0,0 +1,2 @@
+# There is a single LLM content installation per customer, imported using a constant ID
+terraform import observe_llm_content.example llm_content
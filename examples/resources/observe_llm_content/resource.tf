@@ -0,0 +1,2 @@
+# Builds the LLM content on the default tracing datasets
+resource "observe_llm_content" "example" {}
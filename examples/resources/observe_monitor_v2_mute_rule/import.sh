@@ -0,0 +1 @@
+terraform import observe_monitor_v2_mute_rule.example 1414010
@@ -0,0 +1,40 @@
+# mute a single monitor during a one-off maintenance window
+resource "observe_monitor_v2_mute_rule" "maintenance" {
+  name        = "database maintenance"
+  description = "Planned failover of the primary database"
+  monitor     = observe_monitor_v2.example.oid
+
+  schedule {
+    one_time {
+      start_time = "2030-01-01T02:00:00Z"
+      end_time   = "2030-01-01T04:00:00Z"
+    }
+  }
+}
+
+# mute all monitors alerting on the staging environment during weekly deploys
+resource "observe_monitor_v2_mute_rule" "weekly_deploy" {
+  name = "weekly staging deploy"
+
+  schedule {
+    recurring {
+      raw_cron = "0 22 * * 3"
+      timezone = "America/Los_Angeles"
+      duration = "1h"
+    }
+  }
+
+  criteria {
+    compare_terms {
+      comparison {
+        compare_fn   = "equal"
+        value_string = ["staging"]
+      }
+      column {
+        column_path {
+          name = "environment"
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# There is a single monitoring content installation per customer, imported using a constant ID
+terraform import observe_monitoring_content.example monitoring_content
@@ -0,0 +1 @@
+resource "observe_monitoring_content" "example" {}
@@ -0,0 +1,2 @@
+# Use the unversioned object OID to import the owner
+terraform import observe_object_owner.example o:::dataset:41000100
@@ -0,0 +1,17 @@
+data "observe_workspace" "default" {
+  name = "Default"
+}
+
+data "observe_user" "new_owner" {
+  email = "jane.doe@domain.com"
+}
+
+data "observe_dataset" "example" {
+  workspace = data.observe_workspace.default.oid
+  name      = "Service Logs"
+}
+
+resource "observe_object_owner" "example" {
+  object = data.observe_dataset.example.oid
+  owner  = data.observe_user.new_owner.oid
+}
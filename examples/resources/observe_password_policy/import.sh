@@ -0,0 +1,2 @@
+# There is a single password policy per customer, imported using a constant ID
+terraform import observe_password_policy.example password_policy
@@ -0,0 +1,5 @@
+resource "observe_password_policy" "example" {
+  min_length      = 14
+  require_special = true
+  expiration_days = 90
+}
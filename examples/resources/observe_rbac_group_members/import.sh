@@ -0,0 +1,2 @@
+# Use the group ID to import the group's current members
+terraform import observe_rbac_group_members.example 1414010
@@ -0,0 +1,19 @@
+data "observe_user" "alice" {
+  email = "alice@domain.com"
+}
+
+data "observe_user" "bob" {
+  email = "bob@domain.com"
+}
+
+resource "observe_rbac_group" "engineering" {
+  name = "engineering"
+}
+
+resource "observe_rbac_group_members" "engineering" {
+  group = observe_rbac_group.engineering.oid
+  users = [
+    data.observe_user.alice.oid,
+    data.observe_user.bob.oid,
+  ]
+}
@@ -0,0 +1,2 @@
+# There is a single tracing content installation per customer, imported using a constant ID
+terraform import observe_tracing_content.example tracing_content
@@ -0,0 +1,2 @@
+# Builds the tracing content on the default tracing datasets
+resource "observe_tracing_content" "example" {}
@@ -0,0 +1,2 @@
+# Use the user ID to import an existing user
+terraform import observe_user.example 1414010
@@ -0,0 +1,17 @@
+resource "observe_user" "example" {
+  email    = "jane.doe@domain.com"
+  label    = "Jane Doe"
+  timezone = "America/Los_Angeles"
+  locale   = "en-US"
+}
+
+data "observe_rbac_group" "engineering" {
+  name = "engineering"
+}
+
+resource "observe_rbac_group_member" "example" {
+  group = data.observe_rbac_group.engineering.oid
+  member {
+    user = observe_user.example.oid
+  }
+}
@@ -0,0 +1,156 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+const (
+	accessCheckAllowed = "allowed"
+	accessCheckDenied  = "denied"
+)
+
+func dataSourceAccessCheck() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("access_check", "description"),
+		ReadContext: dataSourceAccessCheckRead,
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeUser),
+				Description:      descriptions.Get("access_check", "schema", "user"),
+			},
+			"check": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: descriptions.Get("access_check", "schema", "check", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateEnums(gql.AllAccessActions),
+							Description:      describeEnums(gql.AllAccessActions, descriptions.Get("access_check", "schema", "check", "action")),
+						},
+						"resource": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateOID(),
+							Description:      descriptions.Get("access_check", "schema", "check", "resource"),
+						},
+						"expect": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{accessCheckAllowed, accessCheckDenied}, false)),
+							Description:      descriptions.Get("access_check", "schema", "check", "expect"),
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("access_check", "schema", "check", "allowed"),
+						},
+					},
+				},
+			},
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("access_check", "schema", "allowed"),
+			},
+		},
+	}
+}
+
+func dataSourceAccessCheckRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	var userId *types.UserIdScalar
+	if v, ok := data.GetOk("user"); ok {
+		userOid, err := oid.NewOID(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		userId = oid.OidToUserId(*userOid)
+	}
+
+	checks := data.Get("check").([]interface{})
+	inputs := make([]gql.AccessCheckInput, 0, len(checks))
+	var key []string
+	for _, c := range checks {
+		check := c.(map[string]interface{})
+		key = append(key, check["action"].(string), check["resource"].(string))
+		input := gql.AccessCheckInput{
+			Action: gql.AccessAction(toCamel(check["action"].(string))),
+		}
+		if v := check["resource"].(string); v != "" {
+			resource, err := oid.NewOID(v)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			input.ResourceId = &resource.Id
+		}
+		inputs = append(inputs, input)
+	}
+
+	results, err := client.AccessCheck(ctx, inputs, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// results are returned in the order of the checks
+	if len(results) != len(checks) {
+		return diag.Errorf("expected %d access check results, got %d", len(checks), len(results))
+	}
+
+	allowed := true
+	var mismatches []string
+	for i, result := range results {
+		check := checks[i].(map[string]interface{})
+		check["allowed"] = result.Allowed
+		allowed = allowed && result.Allowed
+
+		got := accessCheckDenied
+		if result.Allowed {
+			got = accessCheckAllowed
+		}
+		if expect := check["expect"].(string); expect != "" && expect != got {
+			target := check["action"].(string)
+			if v := check["resource"].(string); v != "" {
+				target = fmt.Sprintf("%s on %s", target, v)
+			}
+			mismatches = append(mismatches, fmt.Sprintf("%s: expected %s, got %s", target, expect, got))
+		}
+	}
+
+	data.SetId(searchId(data.Get("user"), key))
+
+	if err := data.Set("check", checks); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("allowed", allowed); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if len(mismatches) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Access check did not match expectations",
+			Detail:   strings.Join(mismatches, "\n"),
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,60 @@
+package observe
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceAccessCheck(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				data "observe_user" "system" {
+					email = "%[2]s"
+				}
+
+				data "observe_access_check" "example" {
+					user = data.observe_user.system.oid
+
+					check {
+						action = "dataset_create"
+						expect = "allowed"
+					}
+
+					check {
+						action   = "dataset_edit"
+						resource = observe_datastream.test.dataset
+					}
+				}
+				`, randomPrefix, systemUser()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_access_check.example", "check.#", "2"),
+					resource.TestCheckResourceAttr("data.observe_access_check.example", "check.0.allowed", "true"),
+					resource.TestCheckResourceAttr("data.observe_access_check.example", "check.1.allowed", "true"),
+					resource.TestCheckResourceAttr("data.observe_access_check.example", "allowed", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				data "observe_access_check" "example" {
+					check {
+						action   = "dataset_edit"
+						resource = observe_datastream.test.dataset
+						expect   = "denied"
+					}
+				}
+				`, randomPrefix),
+				ExpectError: regexp.MustCompile("dataset_edit on .*: expected denied, got allowed"),
+			},
+		},
+	})
+}
@@ -0,0 +1,135 @@
+package observe
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceAuthtokens() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("authtoken", "data_source_description"),
+		ReadContext: dataSourceAuthtokensRead,
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeUser),
+				Description:      descriptions.Get("authtoken", "schema", "user"),
+			},
+			"kinds": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateEnums(gql.AllAuthtokenKinds),
+				},
+				Description: describeEnums(gql.AllAuthtokenKinds, descriptions.Get("authtoken", "schema", "kinds")),
+			},
+			"authtokens": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("authtoken", "schema", "authtokens"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "id"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("authtoken", "schema", "name"),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("authtoken", "schema", "description"),
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("authtoken", "schema", "disabled"),
+						},
+						"expiration": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("authtoken", "schema", "expiration"),
+						},
+						"extension": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("authtoken", "schema", "extension"),
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: describeEnums(gql.AllAuthtokenKinds, descriptions.Get("authtoken", "schema", "kind")),
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("authtoken", "schema", "created_by"),
+						},
+						"created_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("authtoken", "schema", "created_date"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuthtokensRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	userOid, err := oid.NewOID(data.Get("user").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var kinds []gql.AuthtokenKind
+	for _, v := range data.Get("kinds").([]interface{}) {
+		kinds = append(kinds, gql.AuthtokenKind(toCamel(v.(string))))
+	}
+
+	result, err := client.SearchAuthtokens(ctx, kinds, oid.OidToUserId(*userOid))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	authtokens := make([]interface{}, 0, len(result))
+	for _, authtoken := range result {
+		var description string
+		if authtoken.Description != nil {
+			description = *authtoken.Description
+		}
+		authtokens = append(authtokens, map[string]interface{}{
+			"id":           authtoken.Id,
+			"name":         authtoken.Name,
+			"description":  description,
+			"disabled":     authtoken.Disabled,
+			"expiration":   authtoken.Expiration.String(),
+			"extension":    (time.Duration(authtoken.ExtensionSeconds) * time.Second).String(),
+			"kind":         toSnake(string(authtoken.Kind)),
+			"created_by":   oid.UserOid(authtoken.CreatedBy).String(),
+			"created_date": authtoken.CreatedDate.String(),
+		})
+	}
+
+	data.SetId(userOid.Id)
+	if err := data.Set("authtokens", authtokens); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
@@ -0,0 +1,48 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceAuthtokens(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	expiration := time.Now().Add(30 * 24 * time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "observe_service_account" "example" {
+						label = "%[1]s"
+					}
+
+					resource "observe_authtoken" "example" {
+						name       = "%[1]s"
+						user       = observe_service_account.example.oid
+						expiration = "%[2]s"
+						extension  = "24h"
+					}
+
+					data "observe_authtokens" "example" {
+						user       = observe_service_account.example.oid
+						depends_on = [observe_authtoken.example]
+					}
+				`, randomPrefix, expiration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_authtokens.example", "authtokens.#", "1"),
+					resource.TestCheckResourceAttrPair("data.observe_authtokens.example", "authtokens.0.id", "observe_authtoken.example", "id"),
+					resource.TestCheckResourceAttr("data.observe_authtokens.example", "authtokens.0.name", randomPrefix),
+					resource.TestCheckResourceAttr("data.observe_authtokens.example", "authtokens.0.kind", "api"),
+					resource.TestCheckResourceAttr("data.observe_authtokens.example", "authtokens.0.extension", "24h0m0s"),
+				),
+			},
+		},
+	})
+}
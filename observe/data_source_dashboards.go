@@ -0,0 +1,241 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/binding"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+// dwSearchSchema returns the schema shared by the dashboard and worksheet
+// search data sources. Results are returned in the list attribute named after
+// the data source.
+func dwSearchSchema(name string, nameDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"workspace": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+			Description:      descriptions.Get(name, "schema", "workspace"),
+		},
+		"folder": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateOID(oid.TypeFolder),
+			Description:      descriptions.Get(name, "schema", "folder"),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions.Get(name, "schema", "name"),
+		},
+		"input_datasets": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+			},
+			Description: descriptions.Get(name, "schema", "input_datasets"),
+		},
+		"parameter_names": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: descriptions.Get(name, "schema", "parameter_names"),
+		},
+		"max_count": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			Description:      descriptions.Get(name, "schema", "max_count"),
+		},
+		name: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: descriptions.Get(name, "schema", name),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: descriptions.Get("common", "schema", "id"),
+					},
+					"oid": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: descriptions.Get("common", "schema", "oid"),
+					},
+					"workspace": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: descriptions.Get("common", "schema", "workspace"),
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: nameDescription,
+					},
+					"datasets": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: descriptions.Get(name, "schema", "datasets"),
+					},
+				},
+			},
+		},
+		"_bindings": { // internal, used for generating bindings for cross-tenant export
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: descriptions.Get(name, "schema", "_bindings"),
+		},
+	}
+}
+
+// newDWSearchInput builds the search terms from the arguments in dwSearchSchema.
+func newDWSearchInput(data *schema.ResourceData) (terms gql.DWSearchInput, maxCount *types.Int64Scalar, err error) {
+	if v, ok := data.GetOk("workspace"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return terms, nil, err
+		}
+		terms.WorkspaceId = []string{id.Id}
+	}
+	if v, ok := data.GetOk("folder"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return terms, nil, err
+		}
+		terms.FolderId = []string{id.Id}
+	}
+	if v, ok := data.GetOk("name"); ok {
+		terms.Name = []string{v.(string)}
+	}
+	if v, ok := data.GetOk("input_datasets"); ok {
+		ids, err := oidsToIds(v.([]interface{}))
+		if err != nil {
+			return terms, nil, err
+		}
+		terms.Input = []gql.InputSearchInput{{Id: ids}}
+	}
+	if v, ok := data.GetOk("parameter_names"); ok {
+		terms.Parameter = []gql.ParameterSearchInput{{Name: makeStrSlice(v.([]interface{}))}}
+	}
+	if v, ok := data.GetOk("max_count"); ok {
+		maxCount = types.Int64Scalar(v.(int)).Ptr()
+	}
+	return terms, maxCount, nil
+}
+
+func datasetOids(ids []string) []interface{} {
+	oids := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		oids = append(oids, oid.DatasetOid(id).String())
+	}
+	return oids
+}
+
+// dwSearchResultsToResourceData sorts the search results by name and sets
+// them, along with the id derived from the search arguments.
+func dwSearchResultsToResourceData(ctx context.Context, client *observe.Client, data *schema.ResourceData, name string, kind binding.Kind, results []map[string]interface{}, warnings []string) (diags diag.Diagnostics) {
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i]["name"] != results[j]["name"] {
+			return results[i]["name"].(string) < results[j]["name"].(string)
+		}
+		return results[i]["id"].(string) < results[j]["id"].(string)
+	})
+
+	items := make([]interface{}, 0, len(results))
+	for _, r := range results {
+		items = append(items, r)
+	}
+
+	for _, w := range warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s search returned a warning", kind),
+			Detail:   w,
+		})
+	}
+
+	data.SetId(searchId(data.Get("workspace"), data.Get("folder"), data.Get("name"), data.Get("input_datasets"), data.Get("parameter_names"), data.Get("max_count")))
+
+	if client.ExportObjectBindings {
+		if err := generateDWSearchBindings(ctx, client, data, name, kind, items); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+
+	if err := data.Set(name, items); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+// Generates bindings for use in cross-tenant exports of search results,
+// replacing workspace and dataset ids. See binding.go for details.
+func generateDWSearchBindings(ctx context.Context, client *observe.Client, data *schema.ResourceData, name string, kind binding.Kind, items []interface{}) error {
+	bindFor := binding.NewKindSet(binding.KindDataset, binding.KindWorkspace)
+	gen, err := binding.NewGenerator(ctx, kind, name, client, bindFor)
+	if err != nil {
+		return fmt.Errorf("failed to initialize binding generator: %w", err)
+	}
+
+	gen.Generate(items)
+	if err := data.Set(name, items); err != nil {
+		return err
+	}
+
+	bindingsJson, err := gen.GetBindingsJson()
+	if err != nil {
+		return err
+	}
+	return data.Set("_bindings", string(bindingsJson))
+}
+
+func dataSourceDashboards() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("dashboards", "description"),
+		ReadContext: dataSourceDashboardsRead,
+		Schema:      dwSearchSchema("dashboards", schemaDashboardNameDescription),
+	}
+}
+
+func dataSourceDashboardsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	terms, maxCount, err := newDWSearchInput(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result, err := client.SearchDashboards(ctx, terms, maxCount)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dashboards := make([]map[string]interface{}, 0, len(result.Dashboards))
+	for _, match := range result.Dashboards {
+		dashboard := match.Dashboard
+		dashboards = append(dashboards, map[string]interface{}{
+			"id":        dashboard.Id,
+			"oid":       oid.DashboardOid(dashboard.Id).String(),
+			"workspace": oid.WorkspaceOid(dashboard.WorkspaceId).String(),
+			"name":      dashboard.Name,
+			"datasets":  datasetOids(dashboard.DatasetIds()),
+		})
+	}
+
+	return dwSearchResultsToResourceData(ctx, client, data, "dashboards", binding.KindDashboard, dashboards, result.Warnings)
+}
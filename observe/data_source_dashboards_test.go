@@ -0,0 +1,129 @@
+package observe
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/observeinc/terraform-provider-observe/client/binding"
+)
+
+func TestAccObserveSourceDashboards(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					data "observe_oid" "dataset" {
+						oid = observe_datastream.test.dataset
+					}
+
+					resource "observe_dashboard" "first" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+						stages = <<-EOF
+						[{
+							"pipeline": "filter true",
+							"input": [{
+								"inputName": "test",
+								"inputRole": "Data",
+								"datasetId": "${data.observe_oid.dataset.id}"
+							}]
+						}]
+						EOF
+					}
+
+					data "observe_dashboards" "search" {
+						workspace      = data.observe_workspace.default.oid
+						input_datasets = [observe_datastream.test.dataset]
+
+						depends_on = [observe_dashboard.first]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_dashboards.search", "dashboards.#", "1"),
+					resource.TestCheckResourceAttrPair("data.observe_dashboards.search", "dashboards.0.oid", "observe_dashboard.first", "oid"),
+					resource.TestCheckResourceAttr("data.observe_dashboards.search", "dashboards.0.name", randomPrefix),
+					resource.TestCheckResourceAttrPair("data.observe_dashboards.search", "dashboards.0.workspace", "data.observe_workspace.default", "oid"),
+					resource.TestCheckResourceAttr("data.observe_dashboards.search", "dashboards.0.datasets.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObserveSourceDashboards_ExportWithBindings(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	// see TestAccObserveSourceDashboard_ExportWithBindings for context on this trick
+	providerPreamble := `
+		terraform {} # trick the testing framework into not mangling our config
+		provider "observe" {
+			export_object_bindings = true
+		}
+	`
+
+	workspaceTfName := fmt.Sprintf("workspace_%s", strings.ToLower(defaultWorkspaceName))
+	workspaceTfLocalBindingVar := fmt.Sprintf("binding__dashboard_dashboards__%s", workspaceTfName)
+	datasetTfLocalBindingVar := fmt.Sprintf("binding__dashboard_dashboards__dataset_%s", randomPrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(providerPreamble+configPreamble+datastreamConfigPreamble+`
+					data "observe_oid" "dataset" {
+						oid = observe_datastream.test.dataset
+					}
+
+					resource "observe_dashboard" "first" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+						stages = <<-EOF
+						[{
+							"pipeline": "filter true",
+							"input": [{
+								"inputName": "test",
+								"inputRole": "Data",
+								"datasetId": "${data.observe_oid.dataset.id}"
+							}]
+						}]
+						EOF
+					}
+
+					data "observe_dashboards" "search" {
+						input_datasets = [observe_datastream.test.dataset]
+
+						depends_on = [observe_dashboard.first]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_dashboards.search", "dashboards.0.workspace", fmt.Sprintf("${local.%s}", workspaceTfLocalBindingVar)),
+					resource.TestCheckResourceAttr("data.observe_dashboards.search", "dashboards.0.datasets.0", fmt.Sprintf("${local.%s}", datasetTfLocalBindingVar)),
+					resource.TestCheckResourceAttrWith("data.observe_dashboards.search", "_bindings", func(value string) error {
+						var bindings binding.BindingsObject
+						if err := json.Unmarshal([]byte(value), &bindings); err != nil {
+							return err
+						}
+						expectedKinds := []binding.Kind{binding.KindDataset, binding.KindWorkspace}
+						if !reflect.DeepEqual(bindings.Kinds, expectedKinds) {
+							return fmt.Errorf("bindings.Kinds does not match: expected %#v, got %#v", expectedKinds, bindings.Kinds)
+						}
+						if _, ok := bindings.Mappings[binding.Ref{Kind: binding.KindDataset, Key: randomPrefix}]; !ok {
+							return fmt.Errorf("bindings.Mappings does not contain a binding for dataset %s, found: %#v", randomPrefix, bindings.Mappings)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDataExportDestination() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("data_export_destination", "description"),
+		ReadContext: dataSourceDataExportDestinationRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				DiffSuppressFunc: diffSuppressWorkspace,
+				Deprecated:       "workspace is no longer required and will be ignored. It may be removed in a future version.",
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			// computed values
+			"aws_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("data_export_destination", "schema", "aws_supported"),
+			},
+			"aws_region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_export_destination", "schema", "aws_region"),
+			},
+			"aws_iam_role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_export_destination", "schema", "aws_iam_role"),
+			},
+		},
+	}
+}
+
+func dataSourceDataExportDestinationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	wsid, err := client.ResolveWorkspaceID(ctx, maybeString(data.GetOk("workspace")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config, err := client.GetDataExportDestinationConfig(ctx, &wsid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := data.Set("workspace", oid.WorkspaceOid(wsid).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("aws_supported", config.AwsSupported); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var region, iamRole string
+	if config.AwsConfig != nil {
+		region = config.AwsConfig.Region
+		iamRole = config.AwsConfig.IamRole
+	}
+	if err := data.Set("aws_region", region); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := data.Set("aws_iam_role", iamRole); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	data.SetId(wsid)
+	return diags
+}
@@ -0,0 +1,23 @@
+package observe
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveDataExportDestination(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "observe_data_export_destination" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.observe_data_export_destination.test", "workspace"),
+					resource.TestCheckResourceAttrSet("data.observe_data_export_destination.test", "aws_supported"),
+				),
+			},
+		},
+	})
+}
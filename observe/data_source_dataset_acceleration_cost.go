@@ -0,0 +1,118 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDatasetAccelerationCost() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("dataset_acceleration_cost", "description"),
+		ReadContext: dataSourceDatasetAccelerationCostRead,
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				Description:      descriptions.Get("dataset_acceleration_cost", "schema", "dataset"),
+			},
+			"interval": accelerationIntervalSchema(false),
+			"absolute_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("dataset_acceleration_cost", "schema", "absolute_cost"),
+			},
+			"additional_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("dataset_acceleration_cost", "schema", "additional_cost"),
+			},
+			"estimates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("dataset_acceleration_cost", "schema", "estimates", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_acceleration_cost", "schema", "estimates", "dataset"),
+						},
+						"absolute_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: descriptions.Get("dataset_acceleration_cost", "schema", "estimates", "absolute_cost"),
+						},
+						"additional_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: descriptions.Get("dataset_acceleration_cost", "schema", "estimates", "additional_cost"),
+						},
+						"absolute_confidence": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: describeEnums(gql.AllConfidenceCostEstimates, descriptions.Get("dataset_acceleration_cost", "schema", "estimates", "absolute_confidence")),
+						},
+						"additional_confidence": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: describeEnums(gql.AllConfidenceCostEstimates, descriptions.Get("dataset_acceleration_cost", "schema", "estimates", "additional_confidence")),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatasetAccelerationCostRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	request, err := newAccelerationRequestInput(data.Get("dataset").(string), data.Get("interval").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result, err := client.EstimateAccelerationJobCost(ctx, &gql.AccelerationJobInput{
+		Requests: []gql.AccelerationRequestInput{*request},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var absoluteCost, additionalCost float64
+	estimates := make([]interface{}, 0, len(result))
+	for _, estimate := range result {
+		absoluteCost += estimate.AbsoluteCostEstimate
+		additionalCost += estimate.AdditionalCostEstimate
+		estimates = append(estimates, map[string]interface{}{
+			"dataset":               oid.DatasetOid(estimate.DatasetId).String(),
+			"absolute_cost":         estimate.AbsoluteCostEstimate,
+			"additional_cost":       estimate.AdditionalCostEstimate,
+			"absolute_confidence":   toSnake(string(estimate.ConfidenceAbsoluteCostEstimate)),
+			"additional_confidence": toSnake(string(estimate.ConfidenceAdditionalCostEstimate)),
+		})
+	}
+
+	data.SetId(request.DatasetId)
+
+	if err := data.Set("absolute_cost", absoluteCost); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("additional_cost", additionalCost); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("estimates", estimates); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
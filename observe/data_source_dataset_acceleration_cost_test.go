@@ -0,0 +1,38 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceDatasetAccelerationCost(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	start := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					data "observe_dataset_acceleration_cost" "example" {
+						dataset = observe_datastream.test.dataset
+
+						interval {
+							start = "%[2]s"
+						}
+					}
+				`, randomPrefix, start),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.observe_dataset_acceleration_cost.example", "absolute_cost"),
+					resource.TestCheckResourceAttrSet("data.observe_dataset_acceleration_cost.example", "additional_cost"),
+					resource.TestCheckResourceAttrPair("data.observe_dataset_acceleration_cost.example", "estimates.0.dataset", "observe_datastream.test", "dataset"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,353 @@
+package observe
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+const (
+	lineageEdgeInput      = "input"
+	lineageEdgeForeignKey = "foreign_key"
+)
+
+// lineageEdge points from a dataset to a dataset that depends on it.
+type lineageEdge struct {
+	From string
+	To   string
+	Kind string
+}
+
+// lineageGraph indexes datasets by id, along with the edges into and out of
+// each of them.
+type lineageGraph struct {
+	datasets   map[string]*gql.DatasetLineageNode
+	upstream   map[string][]lineageEdge
+	downstream map[string][]lineageEdge
+}
+
+func newLineageGraph(datasets []gql.DatasetLineageNode, includeForeignKeys bool) *lineageGraph {
+	g := &lineageGraph{
+		datasets:   make(map[string]*gql.DatasetLineageNode, len(datasets)),
+		upstream:   make(map[string][]lineageEdge),
+		downstream: make(map[string][]lineageEdge),
+	}
+	addEdge := func(e lineageEdge) {
+		g.upstream[e.To] = append(g.upstream[e.To], e)
+		g.downstream[e.From] = append(g.downstream[e.From], e)
+	}
+	for i := range datasets {
+		d := &datasets[i]
+		g.datasets[d.Id] = d
+		for _, id := range d.InputIds() {
+			addEdge(lineageEdge{From: id, To: d.Id, Kind: lineageEdgeInput})
+		}
+		if includeForeignKeys {
+			for _, id := range d.ForeignKeyIds() {
+				// a dataset linking to itself adds nothing to the graph
+				if id != d.Id {
+					addEdge(lineageEdge{From: id, To: d.Id, Kind: lineageEdgeForeignKey})
+				}
+			}
+		}
+	}
+	return g
+}
+
+// walk visits datasets breadth first from root, following edges up to depth
+// hops away, and returns the distance to each dataset visited. Datasets are
+// only visited once, so cycles terminate.
+func (g *lineageGraph) walk(root string, depth int, edges map[string][]lineageEdge, next func(lineageEdge) string) map[string]int {
+	distances := map[string]int{root: 0}
+	frontier := []string{root}
+	for distance := 1; distance <= depth && len(frontier) > 0; distance++ {
+		var nextFrontier []string
+		for _, id := range frontier {
+			for _, e := range edges[id] {
+				n := next(e)
+				if _, ok := distances[n]; ok {
+					continue
+				}
+				if _, ok := g.datasets[n]; !ok {
+					// not visible to us, so we can't walk any further
+					continue
+				}
+				distances[n] = distance
+				nextFrontier = append(nextFrontier, n)
+			}
+		}
+		frontier = nextFrontier
+	}
+	return distances
+}
+
+func dataSourceDatasetLineage() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("dataset_lineage", "description"),
+		ReadContext: dataSourceDatasetLineageRead,
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				Description:      descriptions.Get("dataset_lineage", "schema", "dataset"),
+			},
+			"upstream_depth": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          3,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      descriptions.Get("dataset_lineage", "schema", "upstream_depth"),
+			},
+			"downstream_depth": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          3,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      descriptions.Get("dataset_lineage", "schema", "downstream_depth"),
+			},
+			"include_foreign_keys": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: descriptions.Get("dataset_lineage", "schema", "include_foreign_keys"),
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("dataset_lineage", "schema", "nodes"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "id"),
+						},
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "oid"),
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "workspace"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_lineage", "schema", "name"),
+						},
+						"upstream": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("dataset_lineage", "schema", "upstream"),
+						},
+						"downstream": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("dataset_lineage", "schema", "downstream"),
+						},
+						"distance": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: descriptions.Get("dataset_lineage", "schema", "distance"),
+						},
+						"monitors": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("dataset_lineage", "schema", "monitors"),
+						},
+						"dashboards": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("dataset_lineage", "schema", "dashboards"),
+						},
+					},
+				},
+			},
+			"edges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("dataset_lineage", "schema", "edges"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_lineage", "schema", "from"),
+						},
+						"to": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_lineage", "schema", "to"),
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("dataset_lineage", "schema", "kind"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatasetLineageRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	root, err := oid.NewOID(data.Get("dataset").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the API only exposes the inputs of a dataset, not its dependents, so we
+	// list every dataset once and build the graph locally rather than
+	// issuing a request per node
+	datasets, err := client.ListDatasetLineage(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	g := newLineageGraph(datasets, data.Get("include_foreign_keys").(bool))
+	if _, ok := g.datasets[root.Id]; !ok {
+		return diag.Errorf("dataset %s not found", root)
+	}
+
+	upstream := g.walk(root.Id, data.Get("upstream_depth").(int), g.upstream, func(e lineageEdge) string { return e.From })
+	downstream := g.walk(root.Id, data.Get("downstream_depth").(int), g.downstream, func(e lineageEdge) string { return e.To })
+
+	distances := make(map[string]int, len(upstream)+len(downstream))
+	for id, distance := range upstream {
+		distances[id] = distance
+	}
+	for id, distance := range downstream {
+		if d, ok := distances[id]; !ok || distance < d {
+			distances[id] = distance
+		}
+	}
+
+	ids := make([]string, 0, len(distances))
+	for id := range distances {
+		ids = append(ids, id)
+	}
+
+	monitors, err := client.ListMonitorV2Lineage(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	monitorsByDataset := make(map[string][]string)
+	for _, m := range monitors {
+		for _, id := range m.DatasetIds() {
+			monitorsByDataset[id] = append(monitorsByDataset[id], oid.MonitorV2Oid(m.Id).String())
+		}
+	}
+
+	dashboards, err := client.SearchDashboards(ctx, gql.DWSearchInput{
+		Input: []gql.InputSearchInput{{Id: ids}},
+	}, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, w := range dashboards.Warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Dashboard search returned a warning",
+			Detail:   w,
+		})
+	}
+	dashboardsByDataset := make(map[string][]string)
+	for _, result := range dashboards.Dashboards {
+		for _, id := range result.Dashboard.DatasetIds() {
+			dashboardsByDataset[id] = append(dashboardsByDataset[id], oid.DashboardOid(result.Dashboard.Id).String())
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := ids[i], ids[j]
+		if distances[a] != distances[b] {
+			return distances[a] < distances[b]
+		}
+		if g.datasets[a].Name != g.datasets[b].Name {
+			return g.datasets[a].Name < g.datasets[b].Name
+		}
+		return a < b
+	})
+
+	nodes := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		dataset := g.datasets[id]
+		_, isUpstream := upstream[id]
+		_, isDownstream := downstream[id]
+		monitorOids := monitorsByDataset[id]
+		sort.Strings(monitorOids)
+		dashboardOids := dashboardsByDataset[id]
+		sort.Strings(dashboardOids)
+		nodes = append(nodes, map[string]interface{}{
+			"id":         id,
+			"oid":        oid.DatasetOid(id).String(),
+			"workspace":  oid.WorkspaceOid(dataset.WorkspaceId).String(),
+			"name":       dataset.Name,
+			"upstream":   isUpstream && id != root.Id,
+			"downstream": isDownstream && id != root.Id,
+			"distance":   distances[id],
+			"monitors":   monitorOids,
+			"dashboards": dashboardOids,
+		})
+	}
+
+	// include every edge between the datasets we visited, not just the ones
+	// we walked, so that cycles show up in the output
+	seen := make(map[lineageEdge]struct{})
+	var found []lineageEdge
+	for _, id := range ids {
+		for _, e := range g.downstream[id] {
+			if _, ok := distances[e.To]; !ok {
+				continue
+			}
+			if _, ok := seen[e]; ok {
+				continue
+			}
+			seen[e] = struct{}{}
+			found = append(found, e)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].From != found[j].From {
+			return found[i].From < found[j].From
+		}
+		if found[i].To != found[j].To {
+			return found[i].To < found[j].To
+		}
+		return found[i].Kind < found[j].Kind
+	})
+
+	edges := make([]interface{}, 0, len(found))
+	for _, e := range found {
+		edges = append(edges, map[string]interface{}{
+			"from": oid.DatasetOid(e.From).String(),
+			"to":   oid.DatasetOid(e.To).String(),
+			"kind": e.Kind,
+		})
+	}
+
+	data.SetId(searchId(root.Id, data.Get("upstream_depth"), data.Get("downstream_depth"), data.Get("include_foreign_keys")))
+
+	if err := data.Set("nodes", nodes); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := data.Set("edges", edges); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
@@ -0,0 +1,198 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+)
+
+func TestLineageGraphWalk(t *testing.T) {
+	fk := func(id int64) gql.DatasetLineageNodeForeignKeysForeignKey {
+		return gql.DatasetLineageNodeForeignKeysForeignKey{TargetDataset: types.Int64Scalar(id).Ptr()}
+	}
+	input := func(id string) gql.DatasetLineageNodeInputsDatasetInputDataset {
+		return gql.DatasetLineageNodeInputsDatasetInputDataset{DatasetId: id}
+	}
+
+	// 1 -> 2 -> 3 -> 4, with 2 linking to 4 and 5 reading from a dataset we
+	// can't see
+	datasets := []gql.DatasetLineageNode{
+		{Id: "1"},
+		{Id: "2", Inputs: []gql.DatasetLineageNodeInputsDatasetInputDataset{input("1")}, ForeignKeys: []gql.DatasetLineageNodeForeignKeysForeignKey{fk(4)}},
+		{Id: "3", Inputs: []gql.DatasetLineageNodeInputsDatasetInputDataset{input("2")}},
+		{Id: "4", Inputs: []gql.DatasetLineageNodeInputsDatasetInputDataset{input("3")}},
+		{Id: "5", Inputs: []gql.DatasetLineageNodeInputsDatasetInputDataset{input("6")}},
+	}
+
+	upstream := func(e lineageEdge) string { return e.From }
+	downstream := func(e lineageEdge) string { return e.To }
+
+	testcases := []struct {
+		name        string
+		foreignKeys bool
+		root        string
+		depth       int
+		upstream    bool
+		expected    map[string]int
+	}{
+		{
+			name:     "downstream",
+			root:     "1",
+			depth:    10,
+			expected: map[string]int{"1": 0, "2": 1, "3": 2, "4": 3},
+		},
+		{
+			name:     "downstream limited by depth",
+			root:     "1",
+			depth:    1,
+			expected: map[string]int{"1": 0, "2": 1},
+		},
+		{
+			name:     "upstream",
+			root:     "4",
+			depth:    10,
+			upstream: true,
+			expected: map[string]int{"4": 0, "3": 1, "2": 2, "1": 3},
+		},
+		{
+			name:        "cycle through foreign key",
+			foreignKeys: true,
+			root:        "2",
+			depth:       10,
+			expected:    map[string]int{"2": 0, "3": 1, "4": 2},
+		},
+		{
+			name:        "cycle through foreign key upstream",
+			foreignKeys: true,
+			root:        "2",
+			depth:       10,
+			upstream:    true,
+			expected:    map[string]int{"2": 0, "1": 1, "4": 1, "3": 2},
+		},
+		{
+			name:     "unknown input",
+			root:     "5",
+			depth:    10,
+			upstream: true,
+			expected: map[string]int{"5": 0},
+		},
+		{
+			name:     "zero depth",
+			root:     "1",
+			depth:    0,
+			expected: map[string]int{"1": 0},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newLineageGraph(datasets, tt.foreignKeys)
+			var result map[string]int
+			if tt.upstream {
+				result = g.walk(tt.root, tt.depth, g.upstream, upstream)
+			} else {
+				result = g.walk(tt.root, tt.depth, g.downstream, downstream)
+			}
+			if diff := cmp.Diff(result, tt.expected); diff != "" {
+				t.Errorf("walk() mismatch (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAccObserveSourceDatasetLineage(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_dataset" "b" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-b"
+
+						inputs = { "test" = observe_datastream.test.dataset }
+
+						stage {
+							pipeline = "filter true"
+						}
+					}
+
+					resource "observe_dataset" "a" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-a"
+
+						inputs = { "test" = observe_dataset.b.oid }
+
+						stage {
+							pipeline = "filter true"
+						}
+					}
+
+					data "observe_dataset_lineage" "b" {
+						dataset = observe_dataset.b.oid
+
+						depends_on = [observe_dataset.a]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.b", "nodes.#", "3"),
+					resource.TestCheckResourceAttrPair("data.observe_dataset_lineage.b", "nodes.0.id", "observe_dataset.b", "id"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.b", "nodes.0.distance", "0"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.b", "nodes.0.upstream", "false"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.b", "nodes.0.downstream", "false"),
+					resource.TestCheckTypeSetElemAttrPair("data.observe_dataset_lineage.b", "nodes.*.id", "observe_dataset.a", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.observe_dataset_lineage.b", "nodes.*", map[string]string{"upstream": "true", "distance": "1"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.observe_dataset_lineage.b", "nodes.*", map[string]string{"downstream": "true", "distance": "1"}),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.b", "edges.#", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_dataset" "b" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-b"
+
+						inputs = { "test" = observe_datastream.test.dataset }
+
+						stage {
+							pipeline = "filter true"
+						}
+					}
+
+					resource "observe_dataset" "a" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-a"
+
+						inputs = { "test" = observe_dataset.b.oid }
+
+						stage {
+							pipeline = "filter true"
+						}
+					}
+
+					data "observe_dataset_lineage" "b" {
+						dataset          = observe_dataset.b.oid
+						upstream_depth   = 0
+						downstream_depth = 1
+
+						depends_on = [observe_dataset.a]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.b", "nodes.#", "2"),
+					resource.TestCheckResourceAttrPair("data.observe_dataset_lineage.b", "nodes.1.id", "observe_dataset.a", "id"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.b", "edges.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_dataset_lineage.b", "edges.0.kind", "input"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,189 @@
+package observe
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+var datasetSearchMatchArguments = []string{
+	"labels",
+	"columns",
+	"key_match_types",
+	"foreign_key_targets",
+	"correlation_tags",
+	"interfaces",
+	"sources",
+}
+
+func dataSourceDatasets() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"workspace": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+			Description:      descriptions.Get("datasets", "schema", "workspace"),
+		},
+		"folder": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validateOID(oid.TypeFolder),
+			Description:      descriptions.Get("datasets", "schema", "folder"),
+		},
+		"search_mode": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          toSnake(string(gql.SearchModeInclusivemode)),
+			ValidateDiagFunc: validateEnums(gql.AllSearchModes),
+			Description:      describeEnums(gql.AllSearchModes, descriptions.Get("datasets", "schema", "search_mode")),
+		},
+		"datasets": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: descriptions.Get("datasets", "schema", "datasets"),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: descriptions.Get("common", "schema", "id"),
+					},
+					"oid": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: descriptions.Get("common", "schema", "oid"),
+					},
+					"workspace": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: descriptions.Get("common", "schema", "workspace"),
+					},
+					"folder": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: descriptions.Get("common", "schema", "folder"),
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: descriptions.Get("datasets", "schema", "name"),
+					},
+					"kind": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: describeEnums(gql.AllDatasetKinds, descriptions.Get("datasets", "schema", "kind")),
+					},
+					"matched_labels": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: descriptions.Get("datasets", "schema", "matched_labels"),
+					},
+					"matched_columns": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: descriptions.Get("datasets", "schema", "matched_columns"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, k := range datasetSearchMatchArguments {
+		s[k] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: descriptions.Get("datasets", "schema", k),
+		}
+	}
+
+	return &schema.Resource{
+		Description: descriptions.Get("datasets", "description"),
+		ReadContext: dataSourceDatasetsRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceDatasetsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	matches := make(map[string][]string, len(datasetSearchMatchArguments))
+	for _, k := range datasetSearchMatchArguments {
+		matches[k] = makeStrSlice(data.Get(k).([]interface{}))
+	}
+
+	searchMode := gql.SearchMode(toCamel(data.Get("search_mode").(string)))
+	filter := gql.DatasetSearchFilter{
+		LabelMatches:            matches["labels"],
+		ColumnMatches:           matches["columns"],
+		KeyMatchTypes:           matches["key_match_types"],
+		ForeignKeyTargetMatches: matches["foreign_key_targets"],
+		CorrelationTagMatches:   matches["correlation_tags"],
+		ImplementsInterfaces:    matches["interfaces"],
+		SourceMatches:           matches["sources"],
+		SearchMode:              &searchMode,
+	}
+
+	if v, ok := data.GetOk("workspace"); ok {
+		workspaceId, err := oid.NewOID(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		filter.Projects = []string{workspaceId.Id}
+	}
+
+	var folderId string
+	if v, ok := data.GetOk("folder"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		folderId = id.Id
+	}
+
+	result, err := client.SearchDatasets(ctx, filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// results are ordered by score, which may change as datasets are
+	// modified, so sort them by name to keep the output stable
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Dataset.Name != result[j].Dataset.Name {
+			return result[i].Dataset.Name < result[j].Dataset.Name
+		}
+		return result[i].Dataset.Id < result[j].Dataset.Id
+	})
+
+	datasets := make([]interface{}, 0, len(result))
+	for _, match := range result {
+		dataset := match.Dataset
+		if folderId != "" && dataset.FolderId != folderId {
+			continue
+		}
+		datasets = append(datasets, map[string]interface{}{
+			"id":              dataset.Id,
+			"oid":             oid.DatasetOid(dataset.Id).String(),
+			"workspace":       oid.WorkspaceOid(dataset.WorkspaceId).String(),
+			"folder":          oid.FolderOid(dataset.FolderId, dataset.WorkspaceId).String(),
+			"name":            dataset.Name,
+			"kind":            toSnake(string(dataset.Kind)),
+			"matched_labels":  match.MatchData.MatchedLabel,
+			"matched_columns": match.MatchData.MatchedColumn,
+		})
+	}
+
+	data.SetId(searchId(data.Get("workspace"), data.Get("folder"), data.Get("search_mode"), matches))
+	if err := data.Set("datasets", datasets); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
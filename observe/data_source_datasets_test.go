@@ -0,0 +1,63 @@
+package observe
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceDatasets(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	column := strings.ReplaceAll(randomPrefix, "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_dataset" "b" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-b"
+
+						inputs = { "test" = observe_datastream.test.dataset }
+
+						stage {
+							pipeline = "make_col %[2]s:string(FIELDS)"
+						}
+					}
+
+					resource "observe_dataset" "a" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s-a"
+
+						inputs = { "test" = observe_dataset.b.oid }
+
+						stage {
+							pipeline = "filter true"
+						}
+					}
+
+					data "observe_datasets" "search" {
+						workspace   = data.observe_workspace.default.oid
+						columns     = ["%[2]s"]
+						search_mode = "exclusive_mode"
+
+						depends_on = [observe_dataset.a, observe_dataset.b]
+					}
+				`, randomPrefix, column),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_datasets.search", "datasets.#", "2"),
+					resource.TestCheckResourceAttrPair("data.observe_datasets.search", "datasets.0.id", "observe_dataset.a", "id"),
+					resource.TestCheckResourceAttr("data.observe_datasets.search", "datasets.0.name", randomPrefix+"-a"),
+					resource.TestCheckResourceAttr("data.observe_datasets.search", "datasets.0.kind", "event"),
+					resource.TestCheckResourceAttr("data.observe_datasets.search", "datasets.0.matched_columns.0", column),
+					resource.TestCheckResourceAttrPair("data.observe_datasets.search", "datasets.1.id", "observe_dataset.b", "id"),
+				),
+			},
+		},
+	})
+}
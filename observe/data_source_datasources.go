@@ -0,0 +1,102 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDatasources() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("datasources", "description"),
+		ReadContext: dataSourceDatasourcesRead,
+		Schema: map[string]*schema.Schema{
+			"data_connection": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataConnection),
+				Description:      descriptions.Get("datasources", "schema", "data_connection"),
+			},
+			"datasources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("datasources", "schema", "datasources"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "id"),
+						},
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "oid"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("datasource", "schema", "name"),
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: describeEnums(gql.AllDatasourceTypes, descriptions.Get("datasource", "schema", "type")),
+						},
+						"datastream": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("datasource", "schema", "datastream"),
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("datasource", "schema", "state"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatasourcesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	connectionId, err := oid.NewOID(data.Get("data_connection").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result, err := client.GetDatasourcesByConnection(ctx, connectionId.Id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	datasources := make([]interface{}, 0, len(result))
+	for _, datasource := range result {
+		var datasourceType string
+		if datasource.Type != nil {
+			datasourceType = toSnake(string(*datasource.Type))
+		}
+		datasources = append(datasources, map[string]interface{}{
+			"id":         datasource.Id,
+			"oid":        datasource.Oid().String(),
+			"name":       datasource.Name,
+			"type":       datasourceType,
+			"datastream": oid.DatastreamOid(datasource.DatastreamID).String(),
+			"state":      toSnake(string(datasource.Status.State)),
+		})
+	}
+
+	data.SetId(connectionId.Id)
+	if err := data.Set("datasources", datasources); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
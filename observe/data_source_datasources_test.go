@@ -0,0 +1,55 @@
+package observe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceDatasources(t *testing.T) {
+	moduleID := os.Getenv("OBSERVE_DATA_CONNECTION_MODULE_ID")
+	if moduleID == "" {
+		t.Skip("OBSERVE_DATA_CONNECTION_MODULE_ID must be set to create a data connection.")
+	}
+
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_datastream" "example" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+					}
+
+					resource "observe_data_connection" "example" {
+						name      = "%[1]s"
+						module_id = "%[2]s"
+					}
+
+					resource "observe_datasource" "example" {
+						name            = "%[1]s"
+						data_connection = observe_data_connection.example.oid
+						datastream      = observe_datastream.example.oid
+					}
+
+					data "observe_datasources" "example" {
+						data_connection = observe_data_connection.example.oid
+						depends_on      = [observe_datasource.example]
+					}
+				`, randomPrefix, moduleID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_datasources.example", "datasources.#", "1"),
+					resource.TestCheckResourceAttrPair("data.observe_datasources.example", "datasources.0.oid", "observe_datasource.example", "oid"),
+					resource.TestCheckResourceAttr("data.observe_datasources.example", "datasources.0.name", randomPrefix),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDefaultInstanceDashboard() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("default_instance_dashboard", "data_source_description"),
+
+		ReadContext: dataSourceDefaultInstanceDashboardRead,
+
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				Description:      descriptions.Get("default_instance_dashboard", "schema", "dataset"),
+			},
+			// computed values
+			"dashboard": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("default_instance_dashboard", "schema", "dashboard"),
+			},
+		},
+	}
+}
+
+func dataSourceDefaultInstanceDashboardRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client = meta.(*observe.Client)
+	)
+
+	dsid, _ := oid.NewOID(data.Get("dataset").(string))
+
+	dashid, err := client.GetDefaultInstanceDashboard(ctx, dsid.Id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(dsid.Id)
+
+	if dashid != nil {
+		if err := data.Set("dashboard", oid.DashboardOid(*dashid).String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	return diags
+}
@@ -0,0 +1,91 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceDefaultTagDashboard() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("default_tag_dashboard", "data_source_description"),
+
+		ReadContext: dataSourceDefaultTagDashboardRead,
+
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"tag", "dashboard"},
+				Description:  descriptions.Get("default_tag_dashboard", "schema", "tag"),
+			},
+			"dashboard": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"tag", "dashboard"},
+				ValidateDiagFunc: validateOID(oid.TypeDashboard),
+				Description:      descriptions.Get("default_tag_dashboard", "schema", "dashboard"),
+			},
+			// computed values
+			"tags": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("default_tag_dashboard", "schema", "tags"),
+			},
+		},
+	}
+}
+
+func dataSourceDefaultTagDashboardRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client = meta.(*observe.Client)
+		dashid *string
+	)
+
+	if v, ok := data.GetOk("tag"); ok {
+		tag := v.(string)
+		result, err := client.GetDefaultDashboardForTag(ctx, tag)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		dashid = result
+		data.SetId(tag)
+	} else {
+		id, err := oid.NewOID(data.Get("dashboard").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		dashid = &id.Id
+		data.SetId(id.Id)
+	}
+
+	var (
+		dashboard string
+		tags      []string
+	)
+	if dashid != nil {
+		dashboard = oid.DashboardOid(*dashid).String()
+
+		result, err := client.GetTagsDashboardIsDefaultFor(ctx, *dashid)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		tags = result
+	}
+
+	if err := data.Set("dashboard", dashboard); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("tags", tags); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,140 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceIncident() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("incident", "description"),
+		ReadContext: dataSourceIncidentRead,
+		Schema: map[string]*schema.Schema{
+			// used to lookup the incident
+			"id": { // ObjectId!
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"name", "id"},
+				ValidateDiagFunc: validateID(),
+				Description:      descriptions.Get("common", "schema", "id") + " One of either `id` or `name` must be provided.",
+			},
+			"workspace": { // ObjectId!
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: diffSuppressWorkspace,
+				Deprecated:       "workspace is no longer required and will be ignored. It may be removed in a future version.",
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"name": { // String!
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "id"},
+				Description:  descriptions.Get("incident", "schema", "name") + " One of either `id` or `name` must be provided.",
+			},
+			// fields of IncidentInput
+			"description": { // String
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "description"),
+			},
+			"icon_url": { // String
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"folder": { // ObjectId
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "folder"),
+			},
+			"status": { // IncidentStatus!
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: describeEnums(gql.AllIncidentStatuses, descriptions.Get("incident", "schema", "status")),
+			},
+			// end of IncidentInput
+			"slack_channel": { // [IncidentSlackchannel!]!
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "slack_channel", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_id": { // String!
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("incident", "schema", "slack_channel", "connection_id"),
+						},
+						"channel_id": { // String!
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("incident", "schema", "slack_channel", "channel_id"),
+						},
+					},
+				},
+			},
+			"oid": { // ObjectId!
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"inactive_time": { // Time
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "inactive_time"),
+			},
+			"closed_time": { // Time
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "closed_time"),
+			},
+		},
+	}
+}
+
+func dataSourceIncidentRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client = meta.(*observe.Client)
+		name   = data.Get("name").(string)
+		getID  = data.Get("id").(string)
+	)
+
+	var incident *gql.Incident
+	var err error
+
+	if getID != "" {
+		incident, err = client.GetIncident(ctx, getID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if name != "" {
+		wsid, resolveErr := client.ResolveWorkspaceID(ctx, maybeString(data.GetOk("workspace")))
+		if resolveErr != nil {
+			return diag.FromErr(resolveErr)
+		}
+		incidents, err := client.SearchIncident(ctx, &wsid, &name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(incidents) != 1 {
+			return diag.Errorf("found %d incidents with name %q", len(incidents), name)
+		}
+		incident = &incidents[0]
+	}
+
+	if incident == nil {
+		return diag.Errorf("failed to lookup incident from provided get/search parameters")
+	}
+
+	data.SetId(incident.Id)
+	return resourceIncidentRead(ctx, data, meta)
+}
@@ -0,0 +1,44 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveIncidentDatasource(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_incident" "game_day" {
+						name        = "%[1]s"
+						description = "ingest outage game day"
+					}
+
+					data "observe_incident" "by_id" {
+						id = observe_incident.game_day.id
+					}
+
+					data "observe_incident" "by_name" {
+						name = observe_incident.game_day.name
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.observe_incident.by_id", "workspace"),
+					resource.TestCheckResourceAttr("data.observe_incident.by_id", "name", randomPrefix),
+					resource.TestCheckResourceAttr("data.observe_incident.by_id", "description", "ingest outage game day"),
+					resource.TestCheckResourceAttr("data.observe_incident.by_id", "status", "active"),
+					resource.TestCheckResourceAttrPair("data.observe_incident.by_name", "id", "observe_incident.game_day", "id"),
+					resource.TestCheckResourceAttrPair("data.observe_incident.by_name", "oid", "observe_incident.game_day", "oid"),
+				),
+			},
+		},
+	})
+}
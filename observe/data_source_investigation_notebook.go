@@ -0,0 +1,255 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceInvestigationNotebook() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("investigation_notebook", "description"),
+		ReadContext: dataSourceInvestigationNotebookRead,
+		Schema: map[string]*schema.Schema{
+			// used to lookup the notebook
+			"id": { // ObjectId!
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"name", "id"},
+				ValidateDiagFunc: validateID(),
+				Description:      descriptions.Get("common", "schema", "id") + " One of either `id` or `name` must be provided.",
+			},
+			"workspace": { // ObjectId!
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: diffSuppressWorkspace,
+				Deprecated:       "workspace is no longer required and will be ignored. It may be removed in a future version.",
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"name": { // String!
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "id"},
+				Description:  descriptions.Get("investigation_notebook", "schema", "name") + " One of either `id` or `name` must be provided.",
+			},
+			// fields of InvestigationNotebookInput
+			"description": { // String
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "description"),
+			},
+			"icon_url": { // String
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"folder": { // ObjectId
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "folder"),
+			},
+			"timezone": { // String
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "timezone"),
+			},
+			"notes": { // [String!]!
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("investigation_notebook", "schema", "notes"),
+			},
+			"runbook": { // NotebookRunbookInfo
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "runbook", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": { // String
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "runbook", "url"),
+						},
+						"text": { // String
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "runbook", "text"),
+						},
+					},
+				},
+			},
+			"trigger_context": { // InvestigationNotebookTriggerContext
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "trigger_context", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"monitor": { // ObjectId
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "trigger_context", "monitor"),
+						},
+						"source_url": { // String
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "trigger_context", "source_url"),
+						},
+					},
+				},
+			},
+			"block": { // [NotebookBlock!]
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"markdown": { // NotebookMarkdown
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "markdown", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"text": { // String!
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("investigation_notebook", "schema", "block", "markdown", "text"),
+									},
+								},
+							},
+						},
+						"image": { // NotebookImage
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "image", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"url": { // String
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("investigation_notebook", "schema", "block", "image", "url"),
+									},
+									"description": { // String!
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("investigation_notebook", "schema", "block", "content_description"),
+									},
+								},
+							},
+						},
+						"query": { // NotebookQuery
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "query", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"inputs": { // for building query (MultiStageQuery!)
+										Type:        schema.TypeMap,
+										Computed:    true,
+										Description: descriptions.Get("transform", "schema", "inputs"),
+									},
+									"stage": { // for building query (MultiStageQuery!)
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: descriptions.Get("transform", "schema", "stage", "description"),
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"alias": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: descriptions.Get("transform", "schema", "stage", "alias"),
+												},
+												"input": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: descriptions.Get("transform", "schema", "stage", "input"),
+												},
+												"pipeline": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: descriptions.Get("transform", "schema", "stage", "pipeline"),
+												},
+												"output_stage": {
+													Type:        schema.TypeBool,
+													Computed:    true,
+													Description: descriptions.Get("transform", "schema", "stage", "output_stage"),
+												},
+											},
+										},
+									},
+									"description": { // String!
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("investigation_notebook", "schema", "block", "content_description"),
+									},
+									"render_type": { // NotebookQueryRenderType
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: describeEnums(gql.AllNotebookQueryRenderTypes, descriptions.Get("investigation_notebook", "schema", "block", "query", "render_type")),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			// end of InvestigationNotebookInput
+			"oid": { // ObjectId!
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"summary": { // String!
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "summary"),
+			},
+		},
+	}
+}
+
+func dataSourceInvestigationNotebookRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client = meta.(*observe.Client)
+		name   = data.Get("name").(string)
+		getID  = data.Get("id").(string)
+	)
+
+	var notebook *gql.InvestigationNotebook
+	var err error
+
+	if getID != "" {
+		notebook, err = client.GetInvestigationNotebook(ctx, getID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if name != "" {
+		wsid, resolveErr := client.ResolveWorkspaceID(ctx, maybeString(data.GetOk("workspace")))
+		if resolveErr != nil {
+			return diag.FromErr(resolveErr)
+		}
+		notebooks, err := client.SearchInvestigationNotebook(ctx, &wsid, &name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(notebooks) != 1 {
+			return diag.Errorf("found %d investigation notebooks with name %q", len(notebooks), name)
+		}
+		notebook = &notebooks[0]
+	}
+
+	if notebook == nil {
+		return diag.Errorf("failed to lookup investigation notebook from provided get/search parameters")
+	}
+
+	data.SetId(notebook.Id)
+	return resourceInvestigationNotebookRead(ctx, data, meta)
+}
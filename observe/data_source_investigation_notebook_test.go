@@ -0,0 +1,50 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveInvestigationNotebookDatasource(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_investigation_notebook" "runbook" {
+						name        = "%[1]s"
+						description = "steps to follow when ingest stalls"
+
+						block {
+							markdown {
+								text = "# Check recent events"
+							}
+						}
+					}
+
+					data "observe_investigation_notebook" "by_id" {
+						id = observe_investigation_notebook.runbook.id
+					}
+
+					data "observe_investigation_notebook" "by_name" {
+						name = observe_investigation_notebook.runbook.name
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.observe_investigation_notebook.by_id", "workspace"),
+					resource.TestCheckResourceAttr("data.observe_investigation_notebook.by_id", "name", randomPrefix),
+					resource.TestCheckResourceAttr("data.observe_investigation_notebook.by_id", "description", "steps to follow when ingest stalls"),
+					resource.TestCheckResourceAttr("data.observe_investigation_notebook.by_id", "block.0.markdown.0.text", "# Check recent events"),
+					resource.TestCheckResourceAttrPair("data.observe_investigation_notebook.by_name", "id", "observe_investigation_notebook.runbook", "id"),
+					resource.TestCheckResourceAttrPair("data.observe_investigation_notebook.by_name", "oid", "observe_investigation_notebook.runbook", "oid"),
+				),
+			},
+		},
+	})
+}
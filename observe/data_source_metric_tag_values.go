@@ -0,0 +1,117 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceMetricTagValues() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("metric_tag_values", "description"),
+		ReadContext: dataSourceMetricTagValuesRead,
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("metric_tag_values", "schema", "tag"),
+			},
+			"metrics": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("metric_tag_values", "schema", "metrics"),
+			},
+			"datasets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeDataset),
+				},
+				Description: descriptions.Get("metric_tag_values", "schema", "datasets"),
+			},
+			"kinds": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateEnums(gql.AllTagKinds),
+				},
+				Description: describeEnums(gql.AllTagKinds, descriptions.Get("metric_tag_values", "schema", "kinds")),
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("metric_tag_values", "schema", "prefix"),
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      descriptions.Get("metric_tag_values", "schema", "limit"),
+			},
+			"values": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("metric_tag_values", "schema", "values"),
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: descriptions.Get("metric_tag_values", "schema", "total"),
+			},
+		},
+	}
+}
+
+func dataSourceMetricTagValuesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	filter := gql.MetricTagValuesFilter{
+		Tag:     data.Get("tag").(string),
+		Metrics: makeStrSlice(data.Get("metrics").([]interface{})),
+		Limit:   types.Int64Scalar(data.Get("limit").(int)),
+	}
+
+	if v, ok := data.GetOk("datasets"); ok {
+		ids, err := oidsToIds(v.([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		filter.DatasetIds = ids
+	}
+
+	for _, kind := range data.Get("kinds").([]interface{}) {
+		filter.Kinds = append(filter.Kinds, gql.TagKind(toCamel(kind.(string))))
+	}
+
+	if v, ok := data.GetOk("prefix"); ok {
+		prefix := v.(string)
+		filter.Prefix = &prefix
+	}
+
+	result, err := client.SearchMetricTagValues(ctx, filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(searchId(data.Get("tag"), data.Get("metrics"), data.Get("datasets"), data.Get("kinds"), data.Get("prefix"), data.Get("limit")))
+
+	if err := data.Set("values", result.TagValues); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := data.Set("total", int(result.Total)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
@@ -0,0 +1,53 @@
+package observe
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceMetricTagValues(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	metricName := strings.ReplaceAll(randomPrefix, "-", "_") + "_count"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_log_derived_metric_dataset" "test" {
+						workspace   = data.observe_workspace.default.oid
+						metric_name = "%[2]s"
+						input         = observe_datastream.test.dataset
+						shaping_query = "make_col service:string(FIELDS)"
+
+						aggregation {
+							function = "count"
+						}
+
+						metric_tag {
+							name   = "service"
+							column = "service"
+						}
+					}
+
+					data "observe_metric_tag_values" "search" {
+						tag      = "service"
+						metrics  = ["%[2]s"]
+						datasets = [observe_log_derived_metric_dataset.test.oid]
+						kinds    = ["metric"]
+						limit    = 10
+					}
+				`, randomPrefix, metricName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.observe_metric_tag_values.search", "id"),
+					resource.TestCheckResourceAttrSet("data.observe_metric_tag_values.search", "total"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package observe
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceMetrics() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("metrics", "description"),
+		ReadContext: dataSourceMetricsRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("metrics", "schema", "workspace"),
+			},
+			"datasets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeDataset),
+				},
+				Description: descriptions.Get("metrics", "schema", "datasets"),
+			},
+			"correlation_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("metrics", "schema", "correlation_tags"),
+			},
+			"match": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("metrics", "schema", "match"),
+			},
+			"metrics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("metrics", "schema", "metrics"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "name"),
+						},
+						"name_with_path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "name_with_path"),
+						},
+						"dataset": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "dataset"),
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: describeEnums(gql.AllMetricTypes, descriptions.Get("metrics", "schema", "type")),
+						},
+						"unit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "unit"),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "description"),
+						},
+						"user_defined": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("metrics", "schema", "user_defined"),
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: describeEnums(gql.AllMetricStates, descriptions.Get("metrics", "schema", "state")),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMetricsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	filter := gql.MetricSearchFilter{
+		CorrelationTagMatches: makeStrSlice(data.Get("correlation_tags").([]interface{})),
+		Match:                 data.Get("match").(string),
+	}
+
+	if v, ok := data.GetOk("workspace"); ok {
+		workspaceId, err := oid.NewOID(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		filter.Workspaces = []string{workspaceId.Id}
+	}
+
+	if v, ok := data.GetOk("datasets"); ok {
+		ids, err := oidsToIds(v.([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		filter.InDatasets = ids
+	}
+
+	result, err := client.SearchMetrics(ctx, filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matches := result.Matches
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Metric.Name != matches[j].Metric.Name {
+			return matches[i].Metric.Name < matches[j].Metric.Name
+		}
+		return matches[i].Metric.NameWithPath < matches[j].Metric.NameWithPath
+	})
+
+	metrics := make([]interface{}, 0, len(matches))
+	for _, match := range matches {
+		metric := map[string]interface{}{
+			"name":           match.Metric.Name,
+			"name_with_path": match.Metric.NameWithPath,
+			"type":           toSnake(string(match.Metric.Type)),
+			"unit":           match.Metric.Unit,
+			"description":    match.Metric.Description,
+			"user_defined":   match.Metric.UserDefined,
+			"state":          toSnake(string(match.Metric.State)),
+		}
+		if match.DatasetId != nil {
+			metric["dataset"] = oid.DatasetOid(*match.DatasetId).String()
+		}
+		metrics = append(metrics, metric)
+	}
+
+	data.SetId(searchId(data.Get("workspace"), data.Get("datasets"), data.Get("correlation_tags"), data.Get("match")))
+
+	if err := data.Set("metrics", metrics); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
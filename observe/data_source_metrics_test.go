@@ -0,0 +1,49 @@
+package observe
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceMetrics(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	metricName := strings.ReplaceAll(randomPrefix, "-", "_") + "_count"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_log_derived_metric_dataset" "test" {
+						workspace   = data.observe_workspace.default.oid
+						metric_name = "%[2]s"
+						metric_type = "gauge"
+						input       = observe_datastream.test.dataset
+
+						aggregation {
+							function = "count"
+						}
+					}
+
+					data "observe_metrics" "search" {
+						workspace = data.observe_workspace.default.oid
+						datasets  = [observe_log_derived_metric_dataset.test.oid]
+						match     = "%[2]s"
+					}
+				`, randomPrefix, metricName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_metrics.search", "metrics.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_metrics.search", "metrics.0.name", metricName),
+					resource.TestCheckResourceAttr("data.observe_metrics.search", "metrics.0.type", "gauge"),
+					resource.TestCheckResourceAttr("data.observe_metrics.search", "metrics.0.user_defined", "true"),
+					resource.TestCheckResourceAttrSet("data.observe_metrics.search", "metrics.0.dataset"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,217 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceMonitorV2MuteRule() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("monitor_v2_mute_rule", "description"),
+		ReadContext: dataSourceMonitorV2MuteRuleRead,
+		Schema: map[string]*schema.Schema{
+			// used to lookup the mute rule
+			"id": { // ObjectId!
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"name", "id"},
+				ValidateDiagFunc: validateID(),
+				Description:      descriptions.Get("common", "schema", "id") + " One of either `id` or `name` must be provided.",
+			},
+			"workspace": { // ObjectId!
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: diffSuppressWorkspace,
+				Deprecated:       "workspace is no longer required and will be ignored. It may be removed in a future version.",
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"name": { // String!
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "id"},
+				Description:  descriptions.Get("monitor_v2_mute_rule", "schema", "name") + " One of either `id` or `name` must be provided.",
+			},
+			// fields of MonitorV2MuteRuleInput
+			"description": { // String
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "description"),
+			},
+			"icon_url": { // String
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "icon_url"),
+			},
+			"folder": { // ObjectId
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "folder"),
+			},
+			"monitor": { // ObjectId
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "monitor"),
+			},
+			"schedule": { // MonitorV2MuteRuleSchedule!
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"one_time": { // MonitorV2OneTimeMuteSchedule
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "one_time", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_time": { // Time!
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "one_time", "start_time"),
+									},
+									"end_time": { // Time
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "one_time", "end_time"),
+									},
+								},
+							},
+						},
+						"recurring": { // MonitorV2MuteCronSchedule
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "recurring", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"raw_cron": { // String
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "recurring", "raw_cron"),
+									},
+									"timezone": { // String!
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "recurring", "timezone"),
+									},
+									"duration": { // Duration!
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "recurring", "duration"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"criteria": { // MonitorV2ComparisonExpression
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "criteria", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compare_terms": { // [MonitorV2ComparisonTerm!]
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparison": { // [MonitorV2Comparison!]!
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        monitorV2ComparisonDatasource(),
+										Description: descriptions.Get("monitor_v2_mute_rule", "schema", "criteria", "compare_terms", "comparison"),
+									},
+									"column": { // [MonitorV2Column!]!
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        monitorV2ColumnDatasource(),
+										Description: descriptions.Get("monitor_v2_mute_rule", "schema", "criteria", "compare_terms", "column"),
+									},
+								},
+							},
+						},
+						"operator": { // MonitorV2BooleanOperator!
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitor_v2_mute_rule", "schema", "criteria", "operator"),
+						},
+					},
+				},
+			},
+			// end of MonitorV2MuteRuleInput
+			"oid": { // ObjectId!
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"valid_from": { // Time!
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "valid_from"),
+			},
+			"valid_to": { // Time
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "valid_to"),
+			},
+			"is_global": { // Boolean!
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "is_global"),
+			},
+			"is_conditional": { // Boolean!
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "is_conditional"),
+			},
+		},
+	}
+}
+
+func dataSourceMonitorV2MuteRuleRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	var (
+		client = meta.(*observe.Client)
+		name   = data.Get("name").(string)
+		getID  = data.Get("id").(string)
+	)
+
+	var muteRule *gql.MonitorV2MuteRule
+	var err error
+
+	if getID != "" {
+		muteRule, err = client.GetMonitorV2MuteRule(ctx, getID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if name != "" {
+		wsid, resolveErr := client.ResolveWorkspaceID(ctx, maybeString(data.GetOk("workspace")))
+		if resolveErr != nil {
+			return diag.FromErr(resolveErr)
+		}
+		muteRules, err := client.SearchMonitorV2MuteRule(ctx, &wsid, &name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(muteRules) != 1 {
+			return diag.Errorf("found %d monitor mute rules with name %q", len(muteRules), name)
+		}
+		muteRule = &muteRules[0]
+	}
+
+	if muteRule == nil {
+		return diag.Errorf("failed to lookup monitor mute rule from provided get/search parameters")
+	}
+
+	data.SetId(muteRule.Id)
+	return resourceMonitorV2MuteRuleRead(ctx, data, meta)
+}
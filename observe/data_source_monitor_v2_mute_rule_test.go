@@ -0,0 +1,55 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveMonitorV2MuteRuleDatasource(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(monitorV2MuteRuleConfigPreamble+`
+					resource "observe_monitor_v2_mute_rule" "mute" {
+						name = "%[1]s"
+						description = "scheduled deploy"
+						monitor = observe_monitor_v2.first.oid
+						schedule {
+							recurring {
+								raw_cron = "0 2 * * 6"
+								timezone = "UTC"
+								duration = "30m"
+							}
+						}
+					}
+
+					data "observe_monitor_v2_mute_rule" "by_id" {
+						id = observe_monitor_v2_mute_rule.mute.id
+					}
+
+					data "observe_monitor_v2_mute_rule" "by_name" {
+						name = observe_monitor_v2_mute_rule.mute.name
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.observe_monitor_v2_mute_rule.by_id", "workspace"),
+					resource.TestCheckResourceAttr("data.observe_monitor_v2_mute_rule.by_id", "name", randomPrefix),
+					resource.TestCheckResourceAttr("data.observe_monitor_v2_mute_rule.by_id", "description", "scheduled deploy"),
+					resource.TestCheckResourceAttrPair("data.observe_monitor_v2_mute_rule.by_id", "monitor", "observe_monitor_v2.first", "oid"),
+					resource.TestCheckResourceAttr("data.observe_monitor_v2_mute_rule.by_id", "schedule.0.recurring.0.raw_cron", "0 2 * * 6"),
+					resource.TestCheckResourceAttr("data.observe_monitor_v2_mute_rule.by_id", "schedule.0.recurring.0.timezone", "UTC"),
+					resource.TestCheckResourceAttr("data.observe_monitor_v2_mute_rule.by_id", "schedule.0.recurring.0.duration", "30m0s"),
+					resource.TestCheckResourceAttrPair("data.observe_monitor_v2_mute_rule.by_name", "id", "observe_monitor_v2_mute_rule.mute", "id"),
+					resource.TestCheckResourceAttrPair("data.observe_monitor_v2_mute_rule.by_name", "oid", "observe_monitor_v2_mute_rule.mute", "oid"),
+				),
+			},
+		},
+	})
+}
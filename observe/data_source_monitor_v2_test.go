@@ -310,6 +310,7 @@ func TestAccObserveMonitorV2ExportWithBindings(t *testing.T) {
 						rule_kind = "count"
 						name = "%[3]s"
 						lookback_time = "30m"
+						ai_triaging_mode = "triage"
 						inputs = {
 							"test" = observe_datastream.test.dataset
 							"test2" = observe_datastream.test.dataset
@@ -347,6 +348,7 @@ func TestAccObserveMonitorV2ExportWithBindings(t *testing.T) {
 					resource.TestCheckResourceAttr("data.observe_monitor_v2.lookup", "inputs.test", fmt.Sprintf("${local.%s}", datasetTfLocalBindingVar)),
 					resource.TestCheckResourceAttr("data.observe_monitor_v2.lookup", "inputs.test2", fmt.Sprintf("${local.%s}", datasetTfLocalBindingVar)),
 					resource.TestCheckResourceAttr("data.observe_monitor_v2.lookup", "actions.0.oid", fmt.Sprintf("${local.%s}", actionTfLocalBindingVar)),
+					resource.TestCheckResourceAttr("data.observe_monitor_v2.lookup", "ai_triaging_mode", "triage"),
 					resource.TestCheckResourceAttrWith("data.observe_monitor_v2.lookup", "_bindings", func(value string) error {
 						var bindings binding.BindingsObject
 						if err := json.Unmarshal([]byte(value), &bindings); err != nil {
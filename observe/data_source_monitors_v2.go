@@ -0,0 +1,141 @@
+package observe
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceMonitorsV2() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("monitors_v2", "description"),
+		ReadContext: dataSourceMonitorsV2Read,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				Description:      descriptions.Get("monitors_v2", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("monitors_v2", "schema", "folder"),
+			},
+			"name_substring": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("monitors_v2", "schema", "name_substring"),
+			},
+			"monitors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("monitors_v2", "schema", "monitors"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "id"),
+						},
+						"oid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "oid"),
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("common", "schema", "workspace"),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitorv2", "schema", "name"),
+						},
+						"rule_kind": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("monitorv2", "schema", "rule_kind"),
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: descriptions.Get("monitorv2", "schema", "disabled"),
+						},
+						"actions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("monitors_v2", "schema", "actions"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMonitorsV2Read(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	var workspaceId, folderId, nameSubstring *string
+	if v, ok := data.GetOk("workspace"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		workspaceId = &id.Id
+	}
+	if v, ok := data.GetOk("folder"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		folderId = &id.Id
+	}
+	if v, ok := data.GetOk("name_substring"); ok {
+		nameSubstring = stringPtr(v.(string))
+	}
+
+	result, err := client.SearchMonitorV2s(ctx, workspaceId, folderId, nameSubstring)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Id < result[j].Id
+	})
+
+	monitors := make([]interface{}, 0, len(result))
+	for _, monitor := range result {
+		actions := make([]string, 0, len(monitor.ActionRules))
+		for _, rule := range monitor.ActionRules {
+			actions = append(actions, oid.MonitorV2ActionOid(rule.ActionID).String())
+		}
+		monitors = append(monitors, map[string]interface{}{
+			"id":        monitor.Id,
+			"oid":       monitor.Oid().String(),
+			"workspace": oid.WorkspaceOid(monitor.WorkspaceId).String(),
+			"name":      monitor.Name,
+			"rule_kind": toSnake(string(monitor.RuleKind)),
+			"disabled":  monitor.Disabled != nil && *monitor.Disabled,
+			"actions":   actions,
+		})
+	}
+
+	data.SetId(searchId(data.Get("workspace"), data.Get("folder"), data.Get("name_substring")))
+	if err := data.Set("monitors", monitors); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
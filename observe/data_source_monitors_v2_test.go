@@ -0,0 +1,98 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceMonitorsV2(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(monitorV2ConfigPreamble+`
+					resource "observe_monitor_v2_action" "action" {
+						type = "email"
+						email {
+							subject = "monitors"
+							addresses = ["test@observeinc.com"]
+						}
+						name = "%[1]s"
+					}
+
+					resource "observe_monitor_v2" "a" {
+						workspace = data.observe_workspace.default.oid
+						rule_kind = "count"
+						name = "%[1]s-a"
+						lookback_time = "30m"
+						inputs = {
+							"test" = observe_datastream.test.dataset
+						}
+						stage {
+							pipeline = "filter true"
+						}
+						rules {
+							level = "informational"
+							count {
+								compare_values {
+									compare_fn = "greater"
+									value_int64 = [0]
+								}
+							}
+						}
+						actions {
+							oid = observe_monitor_v2_action.action.oid
+						}
+					}
+
+					resource "observe_monitor_v2" "b" {
+						workspace = data.observe_workspace.default.oid
+						rule_kind = "count"
+						name = "%[1]s-b"
+						lookback_time = "30m"
+						disabled = true
+						inputs = {
+							"test" = observe_datastream.test.dataset
+						}
+						stage {
+							pipeline = "filter true"
+						}
+						rules {
+							level = "informational"
+							count {
+								compare_values {
+									compare_fn = "greater"
+									value_int64 = [0]
+								}
+							}
+						}
+					}
+
+					data "observe_monitors_v2" "search" {
+						workspace      = data.observe_workspace.default.oid
+						name_substring = "%[1]s"
+
+						depends_on = [observe_monitor_v2.a, observe_monitor_v2.b]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_monitors_v2.search", "monitors.#", "2"),
+					resource.TestCheckResourceAttrPair("data.observe_monitors_v2.search", "monitors.0.oid", "observe_monitor_v2.a", "oid"),
+					resource.TestCheckResourceAttr("data.observe_monitors_v2.search", "monitors.0.rule_kind", "count"),
+					resource.TestCheckResourceAttr("data.observe_monitors_v2.search", "monitors.0.disabled", "false"),
+					resource.TestCheckResourceAttr("data.observe_monitors_v2.search", "monitors.0.actions.#", "1"),
+					resource.TestCheckResourceAttrPair("data.observe_monitors_v2.search", "monitors.0.actions.0", "observe_monitor_v2_action.action", "oid"),
+					resource.TestCheckResourceAttrPair("data.observe_monitors_v2.search", "monitors.1.oid", "observe_monitor_v2.b", "oid"),
+					resource.TestCheckResourceAttr("data.observe_monitors_v2.search", "monitors.1.disabled", "true"),
+					resource.TestCheckResourceAttr("data.observe_monitors_v2.search", "monitors.1.actions.#", "0"),
+				),
+			},
+		},
+	})
+}
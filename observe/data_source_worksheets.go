@@ -0,0 +1,48 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/binding"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func dataSourceWorksheets() *schema.Resource {
+	return &schema.Resource{
+		Description: descriptions.Get("worksheets", "description"),
+		ReadContext: dataSourceWorksheetsRead,
+		Schema:      dwSearchSchema("worksheets", schemaWorksheetNameDescription),
+	}
+}
+
+func dataSourceWorksheetsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	terms, maxCount, err := newDWSearchInput(data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result, err := client.SearchWorksheets(ctx, terms, maxCount)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	worksheets := make([]map[string]interface{}, 0, len(result.Worksheets))
+	for _, match := range result.Worksheets {
+		worksheet := match.Worksheet
+		worksheets = append(worksheets, map[string]interface{}{
+			"id":        worksheet.Id,
+			"oid":       oid.WorksheetOid(worksheet.Id).String(),
+			"workspace": oid.WorkspaceOid(worksheet.WorkspaceId).String(),
+			"name":      worksheet.Label,
+			"datasets":  datasetOids(worksheet.DatasetIds()),
+		})
+	}
+
+	return dwSearchResultsToResourceData(ctx, client, data, "worksheets", binding.KindWorksheet, worksheets, result.Warnings)
+}
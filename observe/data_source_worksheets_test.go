@@ -0,0 +1,56 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveSourceWorksheets(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					data "observe_oid" "dataset" {
+						oid = observe_datastream.test.dataset
+					}
+
+					resource "observe_worksheet" "first" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+						queries = <<-EOF
+						[{
+							"pipeline": "filter true",
+							"input": [{
+								"inputName": "test",
+								"inputRole": "Data",
+								"datasetId": "${data.observe_oid.dataset.id}"
+							}]
+						}]
+						EOF
+					}
+
+					data "observe_worksheets" "search" {
+						workspace      = data.observe_workspace.default.oid
+						input_datasets = [observe_datastream.test.dataset]
+
+						depends_on = [observe_worksheet.first]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.observe_worksheets.search", "worksheets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.observe_worksheets.search", "worksheets.0.oid", "observe_worksheet.first", "oid"),
+					resource.TestCheckResourceAttr("data.observe_worksheets.search", "worksheets.0.name", randomPrefix),
+					resource.TestCheckResourceAttrPair("data.observe_worksheets.search", "worksheets.0.workspace", "data.observe_workspace.default", "oid"),
+					resource.TestCheckResourceAttr("data.observe_worksheets.search", "worksheets.0.datasets.#", "1"),
+				),
+			},
+		},
+	})
+}
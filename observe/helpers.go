@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -344,6 +345,17 @@ func diffSuppressTimeDuration(k, prv, nxt string, d *schema.ResourceData) bool {
 	return o == n
 }
 
+// diffSuppressTimestamp treats RFC3339 timestamps denoting the same instant as
+// equal, since the API may return them normalized to a different offset.
+func diffSuppressTimestamp(k, prv, nxt string, d *schema.ResourceData) bool {
+	o, e1 := time.Parse(time.RFC3339, prv)
+	n, e2 := time.Parse(time.RFC3339, nxt)
+	if e1 != nil || e2 != nil {
+		return prv == nxt
+	}
+	return o.Equal(n)
+}
+
 // ceilToDays rounds a duration up to the nearest whole number of days
 func ceilToDays(d time.Duration) time.Duration {
 	if d == 0 {
@@ -591,6 +603,26 @@ func maybeOID(val any, ok bool) *oid.OID {
 	return ret
 }
 
+// oidsToIds converts a list of OIDs, e.g. from a TypeList of dataset OIDs,
+// into the bare ids expected by the API.
+func oidsToIds(v []interface{}) ([]string, error) {
+	ids := make([]string, 0, len(v))
+	for _, s := range v {
+		id, err := oid.NewOID(s.(string))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id.Id)
+	}
+	return ids, nil
+}
+
+// searchId hashes the arguments of a search into the id of a data source, so
+// that the id is stable across reads of the same search.
+func searchId(args ...interface{}) string {
+	return strconv.FormatUint(uint64(crc32.ChecksumIEEE([]byte(fmt.Sprintf("%#v", args)))), 10)
+}
+
 func validateDatasetName() schema.SchemaValidateDiagFunc {
 	return validation.ToDiagFunc(validation.All(
 		validation.StringLenBetween(1, MaxNameLength),
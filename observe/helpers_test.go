@@ -177,6 +177,38 @@ func TestToCamelLower(t *testing.T) {
 	}
 }
 
+func TestDiffSuppressTimestamp(t *testing.T) {
+	testcases := []struct {
+		Prv    string
+		Nxt    string
+		Expect bool
+	}{
+		{Prv: "2024-01-01T00:00:00Z", Nxt: "2024-01-01T00:00:00Z", Expect: true},
+		{Prv: "2024-01-01T00:00:00Z", Nxt: "2023-12-31T16:00:00-08:00", Expect: true},
+		{Prv: "2024-01-01T00:00:00Z", Nxt: "2024-01-01T00:00:01Z", Expect: false},
+		{Prv: "", Nxt: "2024-01-01T00:00:00Z", Expect: false},
+		{Prv: "", Nxt: "", Expect: true},
+	}
+	for _, tt := range testcases {
+		if result := diffSuppressTimestamp("", tt.Prv, tt.Nxt, nil); result != tt.Expect {
+			t.Fatalf("diffSuppressTimestamp(%q, %q): expected %v, got %v", tt.Prv, tt.Nxt, tt.Expect, result)
+		}
+	}
+}
+
+func TestSearchId(t *testing.T) {
+	id := searchId("a", []interface{}{"b"}, 1)
+	if id != searchId("a", []interface{}{"b"}, 1) {
+		t.Fatalf("searchId is not stable")
+	}
+	if id == searchId("ab", []interface{}{}, 1) {
+		t.Fatalf("searchId must distinguish arguments")
+	}
+	if searchId(map[string]int{"a": 1, "b": 2}) != searchId(map[string]int{"b": 2, "a": 1}) {
+		t.Fatalf("searchId must not depend on map ordering")
+	}
+}
+
 func TestValidateID(t *testing.T) {
 	testcases := []struct {
 		input  any
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
 )
 
 func init() {
@@ -105,6 +106,41 @@ func init() {
 		Name: "observe_service_account",
 		F:    serviceAccountSweeper,
 	})
+	resource.AddTestSweepers("observe_monitor_v2_mute_rule", &resource.Sweeper{
+		Name: "observe_monitor_v2_mute_rule",
+		F:    monitorV2MuteRuleSweeper,
+	})
+	resource.AddTestSweepers("observe_data_export_job", &resource.Sweeper{
+		Name: "observe_data_export_job",
+		F:    dataExportJobSweeper,
+	})
+	resource.AddTestSweepers("observe_investigation_notebook", &resource.Sweeper{
+		Name: "observe_investigation_notebook",
+		F:    investigationNotebookSweeper,
+	})
+	resource.AddTestSweepers("observe_incident", &resource.Sweeper{
+		Name: "observe_incident",
+		F:    incidentSweeper,
+	})
+	resource.AddTestSweepers("observe_datasource", &resource.Sweeper{
+		Name: "observe_datasource",
+		F:    datasourceSweeper,
+	})
+	resource.AddTestSweepers("observe_data_connection", &resource.Sweeper{
+		Name: "observe_data_connection",
+		F:    dataConnectionSweeper,
+		Dependencies: []string{
+			"observe_datasource",
+		},
+	})
+	resource.AddTestSweepers("observe_authtoken", &resource.Sweeper{
+		Name: "observe_authtoken",
+		F:    authtokenSweeper,
+	})
+	resource.AddTestSweepers("observe_user", &resource.Sweeper{
+		Name: "observe_user",
+		F:    userSweeper,
+	})
 }
 
 type client struct {
@@ -856,6 +892,250 @@ func serviceAccountSweeper(pattern string) error {
 	return nil
 }
 
+func monitorV2MuteRuleSweeper(pattern string) error {
+	client, err := sharedClient(pattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	workspaces, err := client.ListWorkspaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, workspace := range workspaces {
+		muteRules, err := client.SearchMonitorV2MuteRule(ctx, &workspace.Id, nil)
+		if err != nil {
+			return fmt.Errorf("failed to lookup monitor mute rules: %w", err)
+		}
+
+		for _, muteRule := range muteRules {
+			if client.MatchName(muteRule.Name) {
+				log.Printf("[WARN] Deleting monitor mute rule %s [id=%s]\n", muteRule.Name, muteRule.Id)
+				if err := client.DeleteMonitorV2MuteRule(ctx, muteRule.Id); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func dataExportJobSweeper(pattern string) error {
+	client, err := sharedClient(pattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	workspaces, err := client.ListWorkspaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, workspace := range workspaces {
+		jobs, err := client.SearchDataExportJob(ctx, &workspace.Id, nil)
+		if err != nil {
+			return fmt.Errorf("failed to lookup data export jobs: %w", err)
+		}
+
+		for _, job := range jobs {
+			if client.MatchName(job.Name) {
+				log.Printf("[WARN] Deleting data export job %s [id=%s]\n", job.Name, job.Id)
+				if err := client.DeleteDataExportJob(ctx, job.Id); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func investigationNotebookSweeper(pattern string) error {
+	client, err := sharedClient(pattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	workspaces, err := client.ListWorkspaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, workspace := range workspaces {
+		notebooks, err := client.SearchInvestigationNotebook(ctx, &workspace.Id, nil)
+		if err != nil {
+			return fmt.Errorf("failed to lookup investigation notebooks: %w", err)
+		}
+
+		for _, notebook := range notebooks {
+			if client.MatchName(notebook.Name) {
+				log.Printf("[WARN] Deleting investigation notebook %s [id=%s]\n", notebook.Name, notebook.Id)
+				if err := client.DeleteInvestigationNotebook(ctx, notebook.Id); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func incidentSweeper(pattern string) error {
+	client, err := sharedClient(pattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	workspaces, err := client.ListWorkspaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, workspace := range workspaces {
+		incidents, err := client.SearchIncident(ctx, &workspace.Id, nil)
+		if err != nil {
+			return fmt.Errorf("failed to lookup incidents: %w", err)
+		}
+
+		for _, incident := range incidents {
+			if client.MatchName(incident.Name) {
+				log.Printf("[WARN] Deleting incident %s [id=%s]\n", incident.Name, incident.Id)
+				if err := client.DeleteIncident(ctx, incident.Id); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func datasourceSweeper(pattern string) error {
+	client, err := sharedClient(pattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	workspaces, err := client.ListWorkspaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, workspace := range workspaces {
+		datasources, err := client.SearchDatasource(ctx, &workspace.Id, nil)
+		if err != nil {
+			return fmt.Errorf("failed to lookup datasources: %w", err)
+		}
+
+		for _, datasource := range datasources {
+			if client.MatchName(datasource.Name) {
+				log.Printf("[WARN] Deleting datasource %s [id=%s]\n", datasource.Name, datasource.Id)
+				if err := client.DeleteDatasource(ctx, datasource.Id); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func dataConnectionSweeper(pattern string) error {
+	client, err := sharedClient(pattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	workspaces, err := client.ListWorkspaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, workspace := range workspaces {
+		connections, err := client.SearchDataConnection(ctx, &workspace.Id, nil)
+		if err != nil {
+			return fmt.Errorf("failed to lookup data connections: %w", err)
+		}
+
+		for _, connection := range connections {
+			if client.MatchName(connection.Name) {
+				log.Printf("[WARN] Deleting data connection %s [id=%s]\n", connection.Name, connection.Id)
+				if err := client.DeleteDataConnection(ctx, connection.Id); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func authtokenSweeper(pattern string) error {
+	client, err := sharedClient(pattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	authtokens, err := client.SearchAuthtokens(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to lookup authtokens: %w", err)
+	}
+
+	for _, authtoken := range authtokens {
+		if client.MatchName(authtoken.Name) {
+			log.Printf("[WARN] Deleting authtoken %s [id=%s]\n", authtoken.Name, authtoken.Id)
+			if err := client.DeleteAuthtoken(ctx, authtoken.Id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func userSweeper(pattern string) error {
+	client, err := sharedClient(pattern)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	users, err := client.ListUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list users: %w", err)
+	}
+
+	// users can not be deleted, only deactivated
+	status := gql.UserStatusUserstatusdisabled
+	for _, user := range users {
+		if client.MatchName(user.Email) && user.Status != status {
+			log.Printf("[WARN] Deactivating user %s [id=%s]\n", user.Email, user.Id)
+			if _, err := client.UpdateUser(ctx, user.Id.String(), &gql.UserInput{Status: &status}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func TestMain(m *testing.M) {
 	resource.TestMain(m)
 }
@@ -199,81 +199,121 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"observe_dataset":           dataSourceDataset(),
-			"observe_link":              dataSourceLink(),
-			"observe_workspace":         dataSourceWorkspace(),
-			"observe_query":             dataSourceQuery(),
-			"observe_board":             dataSourceBoard(),
-			"observe_monitor":           dataSourceMonitor(),
-			"observe_monitor_action":    dataSourceMonitorAction(),
-			"observe_datastream":        dataSourceDatastream(),
-			"observe_worksheet":         dataSourceWorksheet(),
-			"observe_dashboard":         dataSourceDashboard(),
-			"observe_folder":            dataSourceFolder(),
-			"observe_app":               dataSourceApp(),
-			"observe_app_version":       dataSourceAppVersion(),
-			"observe_default_dashboard": dataSourceDefaultDashboard(),
-			"observe_terraform":         dataSourceTerraform(),
-			"observe_oid":               dataSourceOID(),
-			"observe_rbac_group":        dataSourceRbacGroup(),
-			"observe_user":              dataSourceUser(),
-			"observe_ingest_info":       dataSourceIngestInfo(),
-			"observe_cloud_info":        dataSourceCloudInfo(),
-			"observe_monitor_v2":        dataSourceMonitorV2(),
-			"observe_monitor_v2_action": dataSourceMonitorV2Action(),
-			"observe_reference_table":   dataSourceReferenceTable(),
-			"observe_report":            dataSourceReport(),
-			"observe_service_account":   dataSourceServiceAccount(),
-			"observe_inbound_share":     dataSourceInboundShare(),
-			"observe_skill":             dataSourceSkill(),
+			"observe_dataset":                    dataSourceDataset(),
+			"observe_datasets":                   dataSourceDatasets(),
+			"observe_dataset_lineage":            dataSourceDatasetLineage(),
+			"observe_metrics":                    dataSourceMetrics(),
+			"observe_metric_tag_values":          dataSourceMetricTagValues(),
+			"observe_link":                       dataSourceLink(),
+			"observe_workspace":                  dataSourceWorkspace(),
+			"observe_query":                      dataSourceQuery(),
+			"observe_board":                      dataSourceBoard(),
+			"observe_monitor":                    dataSourceMonitor(),
+			"observe_monitor_action":             dataSourceMonitorAction(),
+			"observe_datastream":                 dataSourceDatastream(),
+			"observe_worksheet":                  dataSourceWorksheet(),
+			"observe_worksheets":                 dataSourceWorksheets(),
+			"observe_dashboard":                  dataSourceDashboard(),
+			"observe_dashboards":                 dataSourceDashboards(),
+			"observe_folder":                     dataSourceFolder(),
+			"observe_app":                        dataSourceApp(),
+			"observe_app_version":                dataSourceAppVersion(),
+			"observe_default_dashboard":          dataSourceDefaultDashboard(),
+			"observe_default_instance_dashboard": dataSourceDefaultInstanceDashboard(),
+			"observe_default_tag_dashboard":      dataSourceDefaultTagDashboard(),
+			"observe_terraform":                  dataSourceTerraform(),
+			"observe_oid":                        dataSourceOID(),
+			"observe_rbac_group":                 dataSourceRbacGroup(),
+			"observe_access_check":               dataSourceAccessCheck(),
+			"observe_user":                       dataSourceUser(),
+			"observe_ingest_info":                dataSourceIngestInfo(),
+			"observe_cloud_info":                 dataSourceCloudInfo(),
+			"observe_monitor_v2":                 dataSourceMonitorV2(),
+			"observe_monitors_v2":                dataSourceMonitorsV2(),
+			"observe_monitor_v2_action":          dataSourceMonitorV2Action(),
+			"observe_monitor_v2_mute_rule":       dataSourceMonitorV2MuteRule(),
+			"observe_reference_table":            dataSourceReferenceTable(),
+			"observe_report":                     dataSourceReport(),
+			"observe_service_account":            dataSourceServiceAccount(),
+			"observe_inbound_share":              dataSourceInboundShare(),
+			"observe_skill":                      dataSourceSkill(),
+			"observe_data_export_destination":    dataSourceDataExportDestination(),
+			"observe_investigation_notebook":     dataSourceInvestigationNotebook(),
+			"observe_incident":                   dataSourceIncident(),
+			"observe_datasources":                dataSourceDatasources(),
+			"observe_authtokens":                 dataSourceAuthtokens(),
+			"observe_dataset_acceleration_cost":  dataSourceDatasetAccelerationCost(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"observe_dataset":                    resourceDataset(),
-			"observe_source_dataset":             resourceSourceDataset(),
-			"observe_log_derived_metric_dataset": resourceLogDerivedMetricDataset(),
-			"observe_link":                       resourceLink(),
-			"observe_bookmark_group":             resourceBookmarkGroup(),
-			"observe_bookmark":                   resourceBookmark(),
-			"observe_http_post":                  resourceHTTPPost(),
-			"observe_channel_action":             resourceChannelAction(),
-			"observe_channel":                    resourceChannel(),
-			"observe_monitor_action":             resourceMonitorAction(),
-			"observe_monitor_action_attachment":  resourceMonitorActionAttachment(),
-			"observe_monitor":                    resourceMonitor(),
-			"observe_monitor_v2":                 resourceMonitorV2(),
-			"observe_monitor_v2_action":          resourceMonitorV2Action(),
-			"observe_board":                      resourceBoard(),
-			"observe_poller":                     resourcePoller(),
-			"observe_datastream":                 resourceDatastream(),
-			"observe_datastream_token":           resourceDatastreamToken(),
-			"observe_worksheet":                  resourceWorksheet(),
-			"observe_dashboard":                  resourceDashboard(),
-			"observe_folder":                     resourceFolder(),
-			"observe_app":                        resourceApp(),
-			"observe_app_datasource":             resourceAppDataSource(),
-			"observe_preferred_path":             resourcePreferredPath(),
-			"observe_default_dashboard":          resourceDefaultDashboard(),
-			"observe_layered_setting_record":     resourceLayeredSettingRecord(),
-			"observe_correlation_tag":            resourceCorrelationTag(),
-			"observe_dashboard_link":             resourceDashboardLink(),
-			"observe_rbac_group":                 resourceRbacGroup(),
-			"observe_workspace_default_grants":   resourceWorkspaceDefaultGrants(),
-			"observe_rbac_default_group":         resourceRbacDefaultGroup(),
-			"observe_rbac_group_member":          resourceRbacGroupmember(),
-			"observe_rbac_statement":             resourceRbacStatement(),
-			"observe_grant":                      resourceGrant(),
-			"observe_resource_grants":            resourceResourceGrants(),
-			"observe_filedrop":                   resourceFiledrop(),
-			"observe_snowflake_outbound_share":   resourceSnowflakeOutboundShare(),
-			"observe_dataset_outbound_share":     resourceDatasetOutboundShare(),
-			"observe_dataset_query_filter":       resourceDatasetQueryFilter(),
-			"observe_reference_table":            resourceReferenceTable(),
-			"observe_report":                     resourceReport(),
-			"observe_service_account":            resourceServiceAccount(),
-			"observe_drop_filter":                resourceDropFilter(),
-			"observe_ingest_token":               resourceIngestToken(),
-			"observe_inbound_share_table":        resourceInboundShareTable(),
-			"observe_skill":                      resourceSkill(),
+			"observe_dataset":                           resourceDataset(),
+			"observe_source_dataset":                    resourceSourceDataset(),
+			"observe_log_derived_metric_dataset":        resourceLogDerivedMetricDataset(),
+			"observe_link":                              resourceLink(),
+			"observe_bookmark_group":                    resourceBookmarkGroup(),
+			"observe_bookmark":                          resourceBookmark(),
+			"observe_http_post":                         resourceHTTPPost(),
+			"observe_channel_action":                    resourceChannelAction(),
+			"observe_channel":                           resourceChannel(),
+			"observe_monitor_action":                    resourceMonitorAction(),
+			"observe_monitor_action_attachment":         resourceMonitorActionAttachment(),
+			"observe_monitor":                           resourceMonitor(),
+			"observe_monitor_v2":                        resourceMonitorV2(),
+			"observe_monitor_v2_action":                 resourceMonitorV2Action(),
+			"observe_monitor_v2_mute_rule":              resourceMonitorV2MuteRule(),
+			"observe_board":                             resourceBoard(),
+			"observe_poller":                            resourcePoller(),
+			"observe_datastream":                        resourceDatastream(),
+			"observe_datastream_token":                  resourceDatastreamToken(),
+			"observe_worksheet":                         resourceWorksheet(),
+			"observe_dashboard":                         resourceDashboard(),
+			"observe_folder":                            resourceFolder(),
+			"observe_app":                               resourceApp(),
+			"observe_app_datasource":                    resourceAppDataSource(),
+			"observe_preferred_path":                    resourcePreferredPath(),
+			"observe_default_dashboard":                 resourceDefaultDashboard(),
+			"observe_default_instance_dashboard":        resourceDefaultInstanceDashboard(),
+			"observe_default_tag_dashboard":             resourceDefaultTagDashboard(),
+			"observe_layered_setting_record":            resourceLayeredSettingRecord(),
+			"observe_correlation_tag":                   resourceCorrelationTag(),
+			"observe_dashboard_link":                    resourceDashboardLink(),
+			"observe_rbac_group":                        resourceRbacGroup(),
+			"observe_workspace_default_grants":          resourceWorkspaceDefaultGrants(),
+			"observe_rbac_default_group":                resourceRbacDefaultGroup(),
+			"observe_rbac_group_member":                 resourceRbacGroupmember(),
+			"observe_rbac_group_members":                resourceRbacGroupmembers(),
+			"observe_user":                              resourceUser(),
+			"observe_customer_sso":                      resourceCustomerSso(),
+			"observe_password_policy":                   resourcePasswordPolicy(),
+			"observe_object_owner":                      resourceObjectOwner(),
+			"observe_kubernetes_content":                resourceKubernetesContent(),
+			"observe_host_explorer_content":             resourceHostExplorerContent(),
+			"observe_tracing_content":                   resourceTracingContent(),
+			"observe_llm_content":                       resourceLlmContent(),
+			"observe_monitoring_content":                resourceMonitoringContent(),
+			"observe_rbac_statement":                    resourceRbacStatement(),
+			"observe_grant":                             resourceGrant(),
+			"observe_resource_grants":                   resourceResourceGrants(),
+			"observe_filedrop":                          resourceFiledrop(),
+			"observe_snowflake_outbound_share":          resourceSnowflakeOutboundShare(),
+			"observe_dataset_outbound_share":            resourceDatasetOutboundShare(),
+			"observe_data_export_job":                   resourceDataExportJob(),
+			"observe_investigation_notebook":            resourceInvestigationNotebook(),
+			"observe_incident":                          resourceIncident(),
+			"observe_data_connection":                   resourceDataConnection(),
+			"observe_datasource":                        resourceDatasource(),
+			"observe_authtoken":                         resourceAuthtoken(),
+			"observe_acceleration_job":                  resourceAccelerationJob(),
+			"observe_dataset_hibernation":               resourceDatasetHibernation(),
+			"observe_dataset_unhealthy_actions":         resourceDatasetUnhealthyActions(),
+			"observe_default_dataset_unhealthy_actions": resourceDefaultDatasetUnhealthyActions(),
+			"observe_dataset_query_filter":              resourceDatasetQueryFilter(),
+			"observe_reference_table":                   resourceReferenceTable(),
+			"observe_report":                            resourceReport(),
+			"observe_service_account":                   resourceServiceAccount(),
+			"observe_drop_filter":                       resourceDropFilter(),
+			"observe_ingest_token":                      resourceIngestToken(),
+			"observe_inbound_share_table":               resourceInboundShareTable(),
+			"observe_skill":                             resourceSkill(),
 		},
 		TerraformVersion: version.ProviderVersion,
 	}
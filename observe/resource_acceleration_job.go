@@ -0,0 +1,296 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceAccelerationJob() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("acceleration_job", "description"),
+		CreateContext: resourceAccelerationJobCreate,
+		ReadContext:   resourceAccelerationJobRead,
+		DeleteContext: resourceAccelerationJobDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"request": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: descriptions.Get("acceleration_job", "schema", "request", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validateOID(oid.TypeDataset),
+							Description:      descriptions.Get("acceleration_job", "schema", "request", "dataset"),
+						},
+						"interval": accelerationIntervalSchema(true),
+					},
+				},
+			},
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "context"),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("acceleration_job", "schema", "triggers"),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: describeEnums(gql.AllAccelerationJobStates, descriptions.Get("acceleration_job", "schema", "state")),
+			},
+			"progress": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "progress"),
+			},
+			"credits": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "credits"),
+			},
+			"created_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("acceleration_job", "schema", "created_date"),
+			},
+		},
+	}
+}
+
+// accelerationIntervalSchema is shared with the acceleration cost data source,
+// which does not need to force a new resource.
+func accelerationIntervalSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    forceNew,
+		MinItems:    1,
+		Description: descriptions.Get("acceleration_job", "schema", "request", "interval", "description"),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"start": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         forceNew,
+					ValidateDiagFunc: validateTimestamp,
+					Description:      descriptions.Get("acceleration_job", "schema", "request", "interval", "start"),
+				},
+				"end": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         forceNew,
+					ValidateDiagFunc: validateTimestamp,
+					Description:      descriptions.Get("acceleration_job", "schema", "request", "interval", "end"),
+				},
+			},
+		},
+	}
+}
+
+func newAccelerationRequestInput(dataset string, intervals []interface{}) (*gql.AccelerationRequestInput, error) {
+	id, err := oid.NewOID(dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &gql.AccelerationRequestInput{
+		DatasetId: id.Id,
+		Intervals: make([]gql.TimeRangeInput, 0, len(intervals)),
+	}
+
+	for _, i := range intervals {
+		interval := i.(map[string]interface{})
+
+		var timeRange gql.TimeRangeInput
+		start, err := time.Parse(time.RFC3339, interval["start"].(string))
+		if err != nil {
+			return nil, err
+		}
+		timeRange.Start = (*types.TimeScalar)(&start)
+
+		if v := interval["end"].(string); v != "" {
+			end, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, err
+			}
+			timeRange.End = (*types.TimeScalar)(&end)
+		}
+
+		request.Intervals = append(request.Intervals, timeRange)
+	}
+
+	return request, nil
+}
+
+func newAccelerationJobInput(d *schema.ResourceData) (*gql.AccelerationJobInput, diag.Diagnostics) {
+	input := &gql.AccelerationJobInput{}
+
+	for _, r := range d.Get("request").([]interface{}) {
+		req := r.(map[string]interface{})
+		request, err := newAccelerationRequestInput(req["dataset"].(string), req["interval"].([]interface{}))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		input.Requests = append(input.Requests, *request)
+	}
+
+	if v, ok := d.GetOk("context"); ok {
+		input.Context = stringPtr(v.(string))
+	}
+
+	return input, nil
+}
+
+func resourceAccelerationJobCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	input, diags := newAccelerationJobInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	result, err := client.CreateAccelerationJob(ctx, input)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create acceleration job",
+			Detail:   err.Error(),
+		})
+	}
+
+	d.SetId(result.JobId)
+
+	if result.State != gql.AccelerationJobStateCompleted {
+		if wd := waitAccelerationJobCompleted(ctx, d, client); wd.HasError() {
+			return append(diags, wd...)
+		}
+	}
+
+	return append(diags, resourceAccelerationJobRead(ctx, d, m)...)
+}
+
+func waitAccelerationJobCompleted(ctx context.Context, d *schema.ResourceData, client *observe.Client) (diags diag.Diagnostics) {
+	c := &retry.StateChangeConf{
+		Pending: []string{
+			string(gql.AccelerationJobStateRunning),
+		},
+		Target: []string{
+			string(gql.AccelerationJobStateCompleted),
+		},
+		Refresh: func() (any, string, error) {
+			resp, err := client.GetAccelerationJob(ctx, d.Id())
+			if err != nil {
+				return nil, "", err
+			}
+			if resp.State == gql.AccelerationJobStateCancelled {
+				return nil, string(resp.State), fmt.Errorf("acceleration job was cancelled at %.0f%% progress", resp.Progress*100)
+			}
+			return resp, string(resp.State), nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate) - time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := c.WaitForStateContext(ctx); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Error while waiting for acceleration job to complete",
+			Detail:   err.Error(),
+		})
+	}
+
+	return diags
+}
+
+func resourceAccelerationJobRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	job, err := client.GetAccelerationJob(ctx, d.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read acceleration job",
+			Detail:   err.Error(),
+		})
+	}
+
+	if err := d.Set("state", toSnake(string(job.State))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("progress", job.Progress); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if job.Credits != nil {
+		if err := d.Set("credits", *job.Credits); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("created_date", job.CreatedDate.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceAccelerationJobDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	job, err := client.GetAccelerationJob(ctx, d.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read acceleration job",
+			Detail:   err.Error(),
+		})
+	}
+
+	// completed jobs can not be undone, so there is nothing left to delete
+	if job.State != gql.AccelerationJobStateRunning {
+		return diags
+	}
+
+	if _, err := client.CancelAccelerationJob(ctx, d.Id()); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to cancel acceleration job",
+			Detail:   err.Error(),
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,59 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveAccelerationJob(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	start := time.Now().Add(-time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_dataset" "first" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+
+						inputs = {
+							"test" = observe_datastream.test.dataset
+						}
+
+						stage {
+							pipeline = "filter true"
+						}
+					}
+
+					resource "observe_acceleration_job" "backfill" {
+						context = "%[1]s"
+
+						request {
+							dataset = observe_dataset.first.oid
+
+							interval {
+								start = "%[2]s"
+							}
+						}
+
+						triggers = {
+							dataset = observe_dataset.first.oid
+						}
+					}
+				`, randomPrefix, start),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_acceleration_job.backfill", "state", "completed"),
+					resource.TestCheckResourceAttr("observe_acceleration_job.backfill", "progress", "1"),
+					resource.TestCheckResourceAttrSet("observe_acceleration_job.backfill", "created_date"),
+				),
+			},
+		},
+	})
+}
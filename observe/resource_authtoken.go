@@ -0,0 +1,314 @@
+package observe
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceAuthtoken() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("authtoken", "description"),
+		CreateContext: resourceAuthtokenCreate,
+		ReadContext:   resourceAuthtokenRead,
+		UpdateContext: resourceAuthtokenUpdate,
+		DeleteContext: resourceAuthtokenDelete,
+		CustomizeDiff: resourceAuthtokenCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("authtoken", "schema", "name"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("authtoken", "schema", "description"),
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("authtoken", "schema", "disabled"),
+			},
+			"expiration": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateTimestamp,
+				DiffSuppressFunc: diffSuppressTimestamp,
+				Description:      descriptions.Get("authtoken", "schema", "expiration"),
+			},
+			"extension": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "0s",
+				ValidateDiagFunc: validateTimeDuration,
+				DiffSuppressFunc: diffSuppressTimeDuration,
+				Description:      descriptions.Get("authtoken", "schema", "extension"),
+			},
+			"user": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeUser),
+				Description:      descriptions.Get("authtoken", "schema", "user"),
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("authtoken", "schema", "rotation_trigger"),
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("authtoken", "schema", "expires_at"),
+			},
+			"previous_token_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("authtoken", "schema", "previous_token_id"),
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: describeEnums(gql.AllAuthtokenKinds, descriptions.Get("authtoken", "schema", "kind")),
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: descriptions.Get("authtoken", "schema", "secret"),
+			},
+		},
+	}
+}
+
+func resourceAuthtokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("rotation_trigger") {
+		if err := d.SetNewComputed("secret"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("previous_token_id")
+	}
+	return nil
+}
+
+func newAuthtokenInput(d *schema.ResourceData) (*gql.AuthtokenInput, *types.UserIdScalar, diag.Diagnostics) {
+	expiration, err := time.Parse(time.RFC3339, d.Get("expiration").(string))
+	if err != nil {
+		return nil, nil, diag.FromErr(err)
+	}
+
+	extension, err := time.ParseDuration(d.Get("extension").(string))
+	if err != nil {
+		return nil, nil, diag.FromErr(err)
+	}
+
+	input := &gql.AuthtokenInput{
+		Name:             d.Get("name").(string),
+		Disabled:         d.Get("disabled").(bool),
+		Expiration:       types.TimeScalar(expiration),
+		ExtensionSeconds: types.Int64Scalar(extension / time.Second),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	var user *types.UserIdScalar
+	if v, ok := d.GetOk("user"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, nil, diag.FromErr(err)
+		}
+		user = oid.OidToUserId(*id)
+	}
+
+	return input, user, nil
+}
+
+func resourceAuthtokenCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	input, user, diags := newAuthtokenInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	result, secret, err := client.CreateAuthtoken(ctx, input, user, nil)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create authtoken",
+			Detail:   err.Error(),
+		})
+	}
+
+	d.SetId(result.Id)
+	if err := d.Set("secret", secret); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceAuthtokenRead(ctx, d, m)...)
+}
+
+func resourceAuthtokenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	input, user, diags := newAuthtokenInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	// rotate by creating a replacement token, keeping the current one valid
+	// until the next rotation so that consumers can switch over to the new
+	// secret. The token kept by the previous rotation is deleted only once
+	// the replacement exists.
+	if d.HasChange("rotation_trigger") {
+		result, secret, err := client.CreateAuthtoken(ctx, input, user, nil)
+		if err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to rotate authtoken",
+				Detail:   err.Error(),
+			})
+		}
+
+		previous, _ := d.GetChange("previous_token_id")
+		current := d.Id()
+
+		d.SetId(result.Id)
+		if err := d.Set("secret", secret); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		if err := d.Set("previous_token_id", current); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+
+		if err := deletePreviousAuthtoken(ctx, client, previous.(string)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to delete previous authtoken",
+				Detail:   err.Error(),
+			})
+		}
+
+		return append(diags, resourceAuthtokenRead(ctx, d, m)...)
+	}
+
+	if !d.HasChange("user") {
+		user = nil
+	}
+
+	if _, err := client.UpdateAuthtoken(ctx, d.Id(), input, user); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update authtoken",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourceAuthtokenRead(ctx, d, m)...)
+}
+
+func resourceAuthtokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	authtoken, err := client.GetAuthtoken(ctx, d.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read authtoken",
+			Detail:   err.Error(),
+		})
+	}
+
+	if err := d.Set("name", authtoken.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if authtoken.Description != nil {
+		if err := d.Set("description", *authtoken.Description); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("disabled", authtoken.Disabled); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	extension := time.Duration(authtoken.ExtensionSeconds) * time.Second
+
+	// usage pushes the expiration back when an extension is set, so only
+	// read it back when that can't happen, or on import
+	if _, ok := d.GetOk("expiration"); !ok || extension == 0 {
+		if err := d.Set("expiration", authtoken.Expiration.String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("expires_at", authtoken.Expiration.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("extension", extension.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if authtoken.User != nil {
+		if err := d.Set("user", oid.UserOid(*authtoken.User).String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("kind", toSnake(string(authtoken.Kind))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// deletePreviousAuthtoken deletes the token kept valid by the last rotation,
+// if any.
+func deletePreviousAuthtoken(ctx context.Context, client *observe.Client, id string) error {
+	if id == "" {
+		return nil
+	}
+	if err := client.DeleteAuthtoken(ctx, id); err != nil && !gql.HasErrorCode(err, gql.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+func resourceAuthtokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+	if err := deletePreviousAuthtoken(ctx, client, d.Get("previous_token_id").(string)); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete previous authtoken",
+			Detail:   err.Error(),
+		})
+	}
+	if err := client.DeleteAuthtoken(ctx, d.Id()); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete authtoken",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,159 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+)
+
+func TestAccObserveAuthtoken(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	expiration := time.Now().Add(30 * 24 * time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	var firstID, previousID string
+	captureID := func(s *terraform.State) error {
+		previousID = s.RootModule().Resources["observe_authtoken.example"].Primary.ID
+		return nil
+	}
+	checkRotated := func(s *terraform.State) error {
+		rs := s.RootModule().Resources["observe_authtoken.example"].Primary
+		if rs.ID == previousID {
+			return fmt.Errorf("expected authtoken %s to be replaced", rs.ID)
+		}
+		if rs.Attributes["previous_token_id"] != previousID {
+			return fmt.Errorf("expected previous_token_id to be %s, got %s", previousID, rs.Attributes["previous_token_id"])
+		}
+		// the replaced token must remain valid until the next rotation
+		client := testAccProvider.Meta().(*observe.Client)
+		if _, err := client.GetAuthtoken(context.Background(), previousID); err != nil {
+			return fmt.Errorf("expected previous authtoken %s to still exist: %w", previousID, err)
+		}
+		firstID, previousID = previousID, rs.ID
+		return nil
+	}
+	checkFirstDeleted := func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*observe.Client)
+		if _, err := client.GetAuthtoken(context.Background(), firstID); !gql.HasErrorCode(err, gql.ErrNotFound) {
+			return fmt.Errorf("expected authtoken %s to be deleted, got %v", firstID, err)
+		}
+		return nil
+	}
+
+	rotatedConfig := func(trigger string) string {
+		return fmt.Sprintf(`
+			resource "observe_service_account" "example" {
+				label = "%[1]s"
+			}
+
+			resource "observe_authtoken" "example" {
+				name             = "%[1]s"
+				description      = "rotated"
+				user             = observe_service_account.example.oid
+				expiration       = "%[2]s"
+				rotation_trigger = "%[3]s"
+			}
+		`, randomPrefix, expiration, trigger)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "observe_service_account" "example" {
+						label = "%[1]s"
+					}
+
+					resource "observe_authtoken" "example" {
+						name             = "%[1]s"
+						user             = observe_service_account.example.oid
+						expiration       = "%[2]s"
+						rotation_trigger = "1"
+					}
+				`, randomPrefix, expiration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_authtoken.example", "name", randomPrefix),
+					resource.TestCheckResourceAttrPair("observe_authtoken.example", "user", "observe_service_account.example", "oid"),
+					resource.TestCheckResourceAttr("observe_authtoken.example", "expiration", expiration),
+					resource.TestCheckResourceAttr("observe_authtoken.example", "extension", "0s"),
+					resource.TestCheckResourceAttr("observe_authtoken.example", "disabled", "false"),
+					resource.TestCheckResourceAttr("observe_authtoken.example", "kind", "api"),
+					resource.TestCheckResourceAttrSet("observe_authtoken.example", "secret"),
+					captureID,
+				),
+			},
+			{
+				Config: rotatedConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_authtoken.example", "description", "rotated"),
+					resource.TestCheckResourceAttrSet("observe_authtoken.example", "secret"),
+					checkRotated,
+				),
+			},
+			{
+				Config: rotatedConfig("3"),
+				Check: resource.ComposeTestCheckFunc(
+					checkRotated,
+					checkFirstDeleted,
+				),
+			},
+			{
+				ResourceName:            "observe_authtoken.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret", "rotation_trigger", "previous_token_id"},
+			},
+		},
+	})
+}
+
+func TestAccObserveAuthtokenShortenExpiration(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+	later := time.Now().Add(30 * 24 * time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+	earlier := time.Now().Add(7 * 24 * time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	config := func(expiration string) string {
+		return fmt.Sprintf(`
+			resource "observe_service_account" "example" {
+				label = "%[1]s"
+			}
+
+			resource "observe_authtoken" "example" {
+				name       = "%[1]s"
+				user       = observe_service_account.example.oid
+				expiration = "%[2]s"
+				extension  = "24h"
+			}
+		`, randomPrefix, expiration)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config(later),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_authtoken.example", "expiration", later),
+					resource.TestCheckResourceAttrSet("observe_authtoken.example", "expires_at"),
+				),
+			},
+			{
+				// an extension must not prevent shortening the lifetime
+				Config: config(earlier),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_authtoken.example", "expiration", earlier),
+					resource.TestCheckResourceAttr("observe_authtoken.example", "expires_at", earlier),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,163 @@
+package observe
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceCustomerSso() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("customer_sso", "description"),
+		CreateContext: resourceCustomerSsoCreate,
+		ReadContext:   resourceCustomerSsoRead,
+		UpdateContext: resourceCustomerSsoUpdate,
+		DeleteContext: resourceCustomerSsoDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"saml_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("customer_sso", "schema", "saml_url"),
+			},
+			"saml_cert": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: diffSuppressWhitespace,
+				Description:      descriptions.Get("customer_sso", "schema", "saml_cert"),
+			},
+			"scim_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("customer_sso", "schema", "scim_enabled"),
+			},
+			"local_login_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: descriptions.Get("customer_sso", "schema", "local_login_enabled"),
+			},
+			"force_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("customer_sso", "schema", "force_destroy"),
+			},
+			"saml_expires": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("customer_sso", "schema", "saml_expires"),
+			},
+		},
+	}
+}
+
+// diffSuppressWhitespace ignores leading and trailing whitespace, e.g. the
+// trailing newline of a PEM file read with file().
+func diffSuppressWhitespace(k, prv, nxt string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(prv) == strings.TrimSpace(nxt)
+}
+
+func newCustomerSsoInput(d *schema.ResourceData) *gql.CustomerSsoInput {
+	return &gql.CustomerSsoInput{
+		SamlUrl:      stringPtr(d.Get("saml_url").(string)),
+		SamlCert:     stringPtr(d.Get("saml_cert").(string)),
+		ScimFlag:     boolPtr(d.Get("scim_enabled").(bool)),
+		SsoLocalFlag: boolPtr(d.Get("local_login_enabled").(bool)),
+	}
+}
+
+func resourceCustomerSsoCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	// we just set a constant id since there's only one of this config per tenant
+	d.SetId("customer_sso")
+	return resourceCustomerSsoUpdate(ctx, d, m)
+}
+
+func resourceCustomerSsoUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	if _, err := client.UpdateCurrentCustomerSso(ctx, newCustomerSsoInput(d)); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update customer SSO settings",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourceCustomerSsoRead(ctx, d, m)...)
+}
+
+func resourceCustomerSsoRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	sso, err := client.GetCurrentCustomerSso(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read customer SSO settings",
+			Detail:   err.Error(),
+		})
+	}
+
+	if err := d.Set("saml_url", sso.SamlUrl); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("saml_cert", sso.SamlCert); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("scim_enabled", sso.ScimFlag); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("local_login_enabled", sso.SsoLocalFlag); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var samlExpires string
+	if sso.SamlCert != "" {
+		samlExpires = sso.SamlExpires.String()
+	}
+	if err := d.Set("saml_expires", samlExpires); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceCustomerSsoDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	if !d.Get("force_destroy").(bool) {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Refusing to reset customer SSO settings",
+			Detail:   "Set force_destroy = true and apply before destroying this resource.",
+		})
+	}
+
+	input := &gql.CustomerSsoInput{
+		SamlUrl:      stringPtr(""),
+		SamlCert:     stringPtr(""),
+		ScimFlag:     boolPtr(false),
+		SsoLocalFlag: boolPtr(true),
+	}
+	if _, err := client.UpdateCurrentCustomerSso(ctx, input); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to reset customer SSO settings",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,37 @@
+package observe
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveCustomerSso(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// keep local login enabled, so the test can not lock anyone out
+				Config: configPreamble + `
+				resource "observe_customer_sso" "default" {
+					local_login_enabled = true
+					scim_enabled        = false
+					force_destroy       = true
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_customer_sso.default", "local_login_enabled", "true"),
+					resource.TestCheckResourceAttr("observe_customer_sso.default", "scim_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            "observe_customer_sso.default",
+				ImportState:             true,
+				ImportStateId:           "customer_sso",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
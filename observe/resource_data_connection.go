@@ -0,0 +1,303 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceDataConnection() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("data_connection", "description"),
+		CreateContext: resourceDataConnectionCreate,
+		ReadContext:   resourceDataConnectionRead,
+		UpdateContext: resourceDataConnectionUpdate,
+		DeleteContext: resourceDataConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				DiffSuppressFunc: diffSuppressWorkspace,
+				Deprecated:       "workspace is no longer required and will be ignored. It may be removed in a future version.",
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true, // Default folder when unset
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("common", "schema", "folder"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("data_connection", "schema", "name"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("data_connection", "schema", "description"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"module_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: descriptions.Get("data_connection", "schema", "module_id"),
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("data_connection", "schema", "version"),
+			},
+			"variables": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ValidateDiagFunc: validateMapValues(validateIsString()),
+				Description:      descriptions.Get("data_connection", "schema", "variables"),
+			},
+			"outputs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("data_connection", "schema", "outputs", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("data_connection", "schema", "outputs", "name"),
+						},
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("data_connection", "schema", "outputs", "target"),
+						},
+						"dataset_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("data_connection", "schema", "outputs", "dataset_name"),
+						},
+						"metric_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("data_connection", "schema", "outputs", "metric_name"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func makeDataVariableInputs(in map[string]interface{}) []gql.DataVariableInput {
+	variables := make([]gql.DataVariableInput, 0)
+	for k, v := range makeStringMap(in) {
+		variables = append(variables, gql.DataVariableInput{
+			Name:  k,
+			Value: stringPtr(v),
+		})
+	}
+	return variables
+}
+
+// flattenDataVariables is the inverse of makeDataVariableInputs. Variables
+// without a value are left to the module's defaults, so they are omitted.
+func flattenDataVariables(in []gql.DataVariable) map[string]interface{} {
+	variables := make(map[string]interface{}, len(in))
+	for _, v := range in {
+		if v.Value != nil {
+			variables[v.Name] = *v.Value
+		}
+	}
+	return variables
+}
+
+func newDataConnectionInput(d *schema.ResourceData) (*gql.DataConnectionInput, diag.Diagnostics) {
+	input := &gql.DataConnectionInput{
+		Name:      d.Get("name").(string),
+		ModuleID:  d.Get("module_id").(string),
+		Version:   d.Get("version").(string),
+		Variables: makeDataVariableInputs(d.Get("variables").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("folder"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		input.FolderId = id.Version
+	}
+
+	return input, nil
+}
+
+func resourceDataConnectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	wsid, err := client.ResolveWorkspaceID(ctx, maybeString(d.GetOk("workspace")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input, diags := newDataConnectionInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	// pin to the latest version at creation time, so the module is not
+	// upgraded behind the user's back
+	if input.Version == "" {
+		version, err := client.GetLatestDataConnectionModuleVersion(ctx, &wsid, input.ModuleID)
+		if err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to lookup data connection module version",
+				Detail:   err.Error(),
+			})
+		}
+		input.Version = version
+	}
+
+	result, err := client.CreateDataConnection(ctx, wsid, input)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create data connection",
+			Detail:   err.Error(),
+		})
+	}
+
+	d.SetId(result.Id)
+	return append(diags, resourceDataConnectionRead(ctx, d, m)...)
+}
+
+func resourceDataConnectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	input, diags := newDataConnectionInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := client.UpdateDataConnection(ctx, d.Id(), input); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update data connection",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourceDataConnectionRead(ctx, d, m)...)
+}
+
+func resourceDataConnectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	connection, err := client.GetDataConnection(ctx, d.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read data connection",
+			Detail:   err.Error(),
+		})
+	}
+
+	if err := d.Set("oid", connection.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("name", connection.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if connection.Description != nil {
+		if err := d.Set("description", *connection.Description); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if connection.IconUrl != nil {
+		if err := d.Set("icon_url", *connection.IconUrl); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("workspace", oid.WorkspaceOid(connection.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("folder", oid.FolderOid(connection.FolderId, connection.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("module_id", connection.ModuleID); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("version", connection.Version); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("variables", flattenDataVariables(connection.Variables)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	outputs := make([]interface{}, 0, len(connection.Outputs))
+	for _, output := range connection.Outputs {
+		outputs = append(outputs, map[string]interface{}{
+			"name":         output.Name,
+			"target":       output.Target,
+			"dataset_name": output.DatasetName,
+			"metric_name":  output.MetricName,
+		})
+	}
+	if err := d.Set("outputs", outputs); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceDataConnectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+	if err := client.DeleteDataConnection(ctx, d.Id()); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete data connection",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,66 @@
+package observe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveDataConnection(t *testing.T) {
+	moduleID := os.Getenv("OBSERVE_DATA_CONNECTION_MODULE_ID")
+	if moduleID == "" {
+		t.Skip("OBSERVE_DATA_CONNECTION_MODULE_ID must be set to create a data connection.")
+	}
+
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_data_connection" "example" {
+						name      = "%[1]s"
+						module_id = "%[2]s"
+					}
+				`, randomPrefix, moduleID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_data_connection.example", "workspace"),
+					resource.TestCheckResourceAttrSet("observe_data_connection.example", "folder"),
+					resource.TestCheckResourceAttrSet("observe_data_connection.example", "oid"),
+					resource.TestCheckResourceAttr("observe_data_connection.example", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_data_connection.example", "module_id", moduleID),
+					resource.TestCheckResourceAttrSet("observe_data_connection.example", "version"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_data_connection" "example" {
+						name        = "%[1]s"
+						description = "updated"
+						module_id   = "%[2]s"
+
+						variables = {
+							environment = "test"
+						}
+					}
+				`, randomPrefix, moduleID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_data_connection.example", "description", "updated"),
+					resource.TestCheckResourceAttr("observe_data_connection.example", "variables.%", "1"),
+					resource.TestCheckResourceAttr("observe_data_connection.example", "variables.environment", "test"),
+					resource.TestCheckResourceAttrSet("observe_data_connection.example", "version"),
+				),
+			},
+			{
+				ResourceName:      "observe_data_connection.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,393 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceDataExportJob() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("data_export_job", "description"),
+		CreateContext: resourceDataExportJobCreate,
+		ReadContext:   resourceDataExportJobRead,
+		UpdateContext: resourceDataExportJobUpdate,
+		DeleteContext: resourceDataExportJobDelete,
+		CustomizeDiff: resourceDataExportJobCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				DiffSuppressFunc: diffSuppressWorkspace,
+				Deprecated:       "workspace is no longer required and will be ignored. It may be removed in a future version.",
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true, // Default folder when unset
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("common", "schema", "folder"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("data_export_job", "schema", "name"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("data_export_job", "schema", "description"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				DiffSuppressFunc: diffSuppressOIDVersion,
+				Description:      descriptions.Get("data_export_job", "schema", "dataset"),
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateEnums(gql.AllDataExportJobTypes),
+				DiffSuppressFunc: diffSuppressEnums,
+				Description:      describeEnums(gql.AllDataExportJobTypes, descriptions.Get("data_export_job", "schema", "type")),
+			},
+			"format": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateEnums(gql.AllDataExportJobFormats),
+				DiffSuppressFunc: diffSuppressEnums,
+				Description:      describeEnums(gql.AllDataExportJobFormats, descriptions.Get("data_export_job", "schema", "format")),
+			},
+			"destination": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("data_export_job", "schema", "destination"),
+			},
+			"earliest_timestamp": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimestamp,
+				DiffSuppressFunc: diffSuppressTimestamp,
+				Description:      descriptions.Get("data_export_job", "schema", "earliest_timestamp"),
+			},
+			"older_than": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateTimeDuration,
+				DiffSuppressFunc: diffSuppressTimeDuration,
+				Description:      descriptions.Get("data_export_job", "schema", "older_than"),
+			},
+			"retry_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("data_export_job", "schema", "retry_trigger"),
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_export_job", "schema", "state"),
+			},
+			"state_last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_export_job", "schema", "state_last_updated"),
+			},
+			"error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_export_job", "schema", "error"),
+			},
+			"latest_timestamp": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("data_export_job", "schema", "latest_timestamp"),
+			},
+		},
+	}
+}
+
+func resourceDataExportJobCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	// values that are not yet known will be set by the time we apply
+	isSet := func(k string) bool {
+		_, ok := d.GetOk(k)
+		return ok || !d.NewValueKnown(k)
+	}
+
+	jobType := d.Get("type").(string)
+	if gql.DataExportJobType(toCamel(jobType)) == gql.DataExportJobTypeCustom {
+		if !isSet("earliest_timestamp") || !isSet("older_than") {
+			return fmt.Errorf("earliest_timestamp and older_than must be set when type is %q", jobType)
+		}
+	} else if isSet("earliest_timestamp") || isSet("older_than") {
+		return fmt.Errorf("earliest_timestamp and older_than can only be set when type is %q", toSnake(string(gql.DataExportJobTypeCustom)))
+	}
+	return nil
+}
+
+// dataExportJobFormat maps the snake cased format back to its enum value,
+// since formats such as JSON do not round trip through toCamel.
+func dataExportJobFormat(s string) gql.DataExportJobFormat {
+	for _, format := range gql.AllDataExportJobFormats {
+		if toSnake(string(format)) == toSnake(s) {
+			return format
+		}
+	}
+	return gql.DataExportJobFormat(toCamel(s))
+}
+
+func newDataExportJobInput(d *schema.ResourceData) (*gql.DataExportJobInput, diag.Diagnostics) {
+	datasetId, err := oid.NewOID(d.Get("dataset").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	input := &gql.DataExportJobInput{
+		Name:        d.Get("name").(string),
+		DatasetID:   datasetId.Id,
+		Type:        gql.DataExportJobType(toCamel(d.Get("type").(string))),
+		Format:      dataExportJobFormat(d.Get("format").(string)),
+		Destination: d.Get("destination").(string),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("folder"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		input.FolderId = id.Version
+	}
+
+	if v, ok := d.GetOk("earliest_timestamp"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		ts := types.TimeScalar(t)
+		input.EarliestTimestamp = &ts
+	}
+
+	if v, ok := d.GetOk("older_than"); ok {
+		olderThan, err := types.ParseDurationScalar(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		input.OlderThan = olderThan
+	}
+
+	return input, nil
+}
+
+func resourceDataExportJobCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	wsid, err := client.ResolveWorkspaceID(ctx, maybeString(d.GetOk("workspace")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input, diags := newDataExportJobInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	result, err := client.CreateDataExportJob(ctx, wsid, input)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create data export job",
+			Detail:   err.Error(),
+		})
+	}
+
+	d.SetId(result.Id)
+	return append(diags, resourceDataExportJobRead(ctx, d, m)...)
+}
+
+func resourceDataExportJobUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	input, diags := newDataExportJobInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	result, err := client.UpdateDataExportJob(ctx, d.Id(), input)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update data export job",
+			Detail:   err.Error(),
+		})
+	}
+
+	// only failed jobs can be retried
+	if d.HasChange("retry_trigger") {
+		if result.State != gql.DataExportJobStateFailed {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Data export job not retried",
+				Detail:   fmt.Sprintf("retry_trigger changed, but the job is %s rather than failed.", toSnake(string(result.State))),
+			})
+		} else if _, err := client.RetryDataExportJob(ctx, result.Id); err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retry data export job",
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return append(diags, resourceDataExportJobRead(ctx, d, m)...)
+}
+
+func resourceDataExportJobRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	job, err := client.GetDataExportJob(ctx, d.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read data export job",
+			Detail:   err.Error(),
+		})
+	}
+
+	if err := d.Set("oid", job.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("name", job.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if job.Description != nil {
+		if err := d.Set("description", *job.Description); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if job.IconUrl != nil {
+		if err := d.Set("icon_url", *job.IconUrl); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("workspace", oid.WorkspaceOid(job.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("folder", oid.FolderOid(job.FolderId, job.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("dataset", oid.DatasetOid(job.DatasetID).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("type", toSnake(string(job.Type))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("format", toSnake(string(job.Format))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("destination", job.Destination); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	// the backend may fill in earliestTimestamp and olderThan for other job
+	// types, but they are only configurable for custom jobs.
+	if job.Type == gql.DataExportJobTypeCustom {
+		if job.EarliestTimestamp != nil {
+			if err := d.Set("earliest_timestamp", job.EarliestTimestamp.String()); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+
+		if job.OlderThan != nil {
+			if err := d.Set("older_than", job.OlderThan.String()); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+	}
+
+	if err := d.Set("state", toSnake(string(job.State))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("state_last_updated", job.StateLastUpdatedDate.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var jobError string
+	if job.Error != nil {
+		jobError = *job.Error
+	}
+	if err := d.Set("error", jobError); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var latestTimestamp string
+	if job.LatestTimestamp != nil {
+		latestTimestamp = job.LatestTimestamp.String()
+	}
+	if err := d.Set("latest_timestamp", latestTimestamp); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceDataExportJobDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+	if err := client.DeleteDataExportJob(ctx, d.Id()); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete data export job",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,143 @@
+package observe
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var dataExportJobConfigPreamble = configPreamble + datastreamConfigPreamble + `
+	resource "observe_dataset" "test" {
+		workspace = data.observe_workspace.default.oid
+		name      = "%[1]s-ds"
+
+		inputs = {
+			"test" = observe_datastream.test.dataset
+		}
+
+		stage {}
+	}
+`
+
+func TestAccObserveDataExportJob(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(dataExportJobConfigPreamble+`
+					resource "observe_data_export_job" "test" {
+						name        = "%[1]s"
+						description = "test description"
+						dataset     = observe_dataset.test.oid
+						type        = "live"
+						format      = "json"
+						destination = "s3://observe-terraform-provider-test/%[1]s"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_data_export_job.test", "workspace"),
+					resource.TestCheckResourceAttrSet("observe_data_export_job.test", "folder"),
+					resource.TestCheckResourceAttrSet("observe_data_export_job.test", "oid"),
+					resource.TestCheckResourceAttrSet("observe_data_export_job.test", "dataset"),
+					resource.TestCheckResourceAttr("observe_data_export_job.test", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_data_export_job.test", "description", "test description"),
+					resource.TestCheckResourceAttr("observe_data_export_job.test", "type", "live"),
+					resource.TestCheckResourceAttr("observe_data_export_job.test", "format", "json"),
+					resource.TestCheckResourceAttr("observe_data_export_job.test", "destination", fmt.Sprintf("s3://observe-terraform-provider-test/%s", randomPrefix)),
+					resource.TestCheckResourceAttrSet("observe_data_export_job.test", "state"),
+					resource.TestCheckResourceAttrSet("observe_data_export_job.test", "state_last_updated"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(dataExportJobConfigPreamble+`
+					resource "observe_data_export_job" "test" {
+						name               = "%[1]s"
+						dataset            = observe_dataset.test.oid
+						type               = "custom"
+						format             = "parquet"
+						destination        = "s3://observe-terraform-provider-test/%[1]s"
+						earliest_timestamp = "2024-01-01T00:00:00Z"
+						older_than         = "3h"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_data_export_job.test", "description", ""),
+					resource.TestCheckResourceAttr("observe_data_export_job.test", "type", "custom"),
+					resource.TestCheckResourceAttr("observe_data_export_job.test", "format", "parquet"),
+					resource.TestCheckResourceAttr("observe_data_export_job.test", "earliest_timestamp", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("observe_data_export_job.test", "older_than", "3h0m0s"),
+				),
+			},
+			{
+				// the job has not failed, so changing retry_trigger only warns
+				Config: fmt.Sprintf(dataExportJobConfigPreamble+`
+					resource "observe_data_export_job" "test" {
+						name               = "%[1]s"
+						dataset            = observe_dataset.test.oid
+						type               = "custom"
+						format             = "parquet"
+						destination        = "s3://observe-terraform-provider-test/%[1]s"
+						earliest_timestamp = "2024-01-01T00:00:00Z"
+						older_than         = "3h"
+						retry_trigger      = "1"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_data_export_job.test", "retry_trigger", "1"),
+					resource.TestCheckResourceAttrSet("observe_data_export_job.test", "state"),
+				),
+			},
+			{
+				ResourceName:            "observe_data_export_job.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retry_trigger"},
+			},
+		},
+	})
+}
+
+func TestAccObserveDataExportJobValidation(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(dataExportJobConfigPreamble+`
+					resource "observe_data_export_job" "test" {
+						name        = "%[1]s"
+						dataset     = observe_dataset.test.oid
+						type        = "custom"
+						format      = "json"
+						destination = "s3://observe-terraform-provider-test/%[1]s"
+						older_than  = "3h"
+					}
+				`, randomPrefix),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`earliest_timestamp and older_than must be set when type is "custom"`),
+			},
+			{
+				Config: fmt.Sprintf(dataExportJobConfigPreamble+`
+					resource "observe_data_export_job" "test" {
+						name               = "%[1]s"
+						dataset            = observe_dataset.test.oid
+						type               = "retention"
+						format             = "json"
+						destination        = "s3://observe-terraform-provider-test/%[1]s"
+						earliest_timestamp = "2024-01-01T00:00:00Z"
+					}
+				`, randomPrefix),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`earliest_timestamp and older_than can only be set when type is "custom"`),
+			},
+		},
+	})
+}
@@ -196,6 +196,22 @@ type ResourceReader interface {
 	GetOk(key string) (interface{}, bool)
 }
 
+// prefixedResourceReader reads attributes nested under prefix, which allows
+// helpers written against top level attributes (e.g. newQuery) to be reused
+// for nested blocks.
+type prefixedResourceReader struct {
+	ResourceReader
+	prefix string
+}
+
+func (r prefixedResourceReader) Get(key string) interface{} {
+	return r.ResourceReader.Get(r.prefix + key)
+}
+
+func (r prefixedResourceReader) GetOk(key string) (interface{}, bool) {
+	return r.ResourceReader.GetOk(r.prefix + key)
+}
+
 func resourceDatasetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	client := meta.(*observe.Client)
 	omitVersion := client.Flags[flagOmitDatasetOIDVersion]
@@ -439,6 +455,23 @@ func flattenAndSetQuery(data *schema.ResourceData, gqlstages []gql.StageQuery, o
 		return nil, err
 	}
 
+	inputs, stages := flattenQueryAttributes(data, queryData)
+
+	if err := data.Set("inputs", inputs); err != nil {
+		return nil, err
+	}
+
+	if err := data.Set("stage", stages); err != nil {
+		return nil, err
+	}
+
+	return queryData.StageIds, nil
+}
+
+// flattenQueryAttributes converts queryData into values for the "inputs" and
+// "stage" attributes, using the current values in data to maintain input
+// versions and the input of the first stage.
+func flattenQueryAttributes(data ResourceReader, queryData *Query) (map[string]interface{}, []interface{}) {
 	inputs := make(map[string]interface{}, 0)
 	for name, input := range queryData.Inputs {
 		id := oid.OID{
@@ -456,10 +489,6 @@ func flattenAndSetQuery(data *schema.ResourceData, gqlstages []gql.StageQuery, o
 		inputs[name] = id.String()
 	}
 
-	if err := data.Set("inputs", inputs); err != nil {
-		return nil, err
-	}
-
 	stages := make([]interface{}, len(queryData.Stages))
 	for i, stage := range queryData.Stages {
 		s := map[string]interface{}{
@@ -477,11 +506,7 @@ func flattenAndSetQuery(data *schema.ResourceData, gqlstages []gql.StageQuery, o
 		stages[i] = s
 	}
 
-	if err := data.Set("stage", stages); err != nil {
-		return nil, err
-	}
-
-	return queryData.StageIds, nil
+	return inputs, stages
 }
 
 func resourceDatasetCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
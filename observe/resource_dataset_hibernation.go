@@ -0,0 +1,216 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceDatasetHibernation() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("dataset_hibernation", "description"),
+		CreateContext: resourceDatasetHibernationCreate,
+		ReadContext:   resourceDatasetHibernationRead,
+		UpdateContext: resourceDatasetHibernationUpdate,
+		DeleteContext: resourceDatasetHibernationDelete,
+		CustomizeDiff: resourceDatasetHibernationCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				DiffSuppressFunc: diffSuppressOIDVersion,
+				Description:      descriptions.Get("dataset_hibernation", "schema", "dataset"),
+			},
+			"hibernated": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: descriptions.Get("dataset_hibernation", "schema", "hibernated"),
+			},
+			"wake_up_downstreams": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("dataset_hibernation", "schema", "wake_up_downstreams"),
+			},
+			"hibernated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("dataset_hibernation", "schema", "hibernated_at"),
+			},
+			"affected_datasets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("dataset_hibernation", "schema", "affected_datasets"),
+			},
+		},
+	}
+}
+
+func resourceDatasetHibernationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("hibernated") {
+		if err := d.SetNewComputed("hibernated_at"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("affected_datasets")
+	}
+	return nil
+}
+
+func datasetHibernationAction(d *schema.ResourceData) gql.HibernationActionType {
+	switch {
+	case d.Get("hibernated").(bool):
+		return gql.HibernationActionTypeHibernate
+	case d.Get("wake_up_downstreams").(bool):
+		return gql.HibernationActionTypeWakeupincludingdownstreams
+	default:
+		return gql.HibernationActionTypeWakeup
+	}
+}
+
+// applyDatasetHibernationAction applies the action to the dataset, returning
+// the OIDs of all affected datasets.
+func applyDatasetHibernationAction(ctx context.Context, client *observe.Client, datasetId string, action gql.HibernationActionType) ([]string, error) {
+	result, err := client.HibernationAction(ctx, datasetId, action, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Status != gql.HiberationActionStatusSuccess {
+		var reasons []string
+		for _, dataset := range result.Datasets {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", oid.DatasetOid(dataset.DatasetId), toSnake(string(dataset.Status))))
+		}
+		return nil, fmt.Errorf("%s failed with status %q: %s", toSnake(string(action)), toSnake(string(result.Status)), strings.Join(reasons, ", "))
+	}
+
+	affected := make([]string, 0, len(result.Datasets))
+	for _, dataset := range result.Datasets {
+		affected = append(affected, oid.DatasetOid(dataset.DatasetId).String())
+	}
+	return affected, nil
+}
+
+func resourceDatasetHibernationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	id, err := oid.NewOID(d.Get("dataset").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	affected, err := applyDatasetHibernationAction(ctx, client, id.Id, datasetHibernationAction(d))
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update dataset hibernation",
+			Detail:   err.Error(),
+		})
+	}
+
+	d.SetId(id.Id)
+	if err := d.Set("affected_datasets", affected); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceDatasetHibernationRead(ctx, d, m)...)
+}
+
+func resourceDatasetHibernationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	if d.HasChange("hibernated") {
+		affected, err := applyDatasetHibernationAction(ctx, client, d.Id(), datasetHibernationAction(d))
+		if err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update dataset hibernation",
+				Detail:   err.Error(),
+			})
+		}
+
+		if err := d.Set("affected_datasets", affected); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return append(diags, resourceDatasetHibernationRead(ctx, d, m)...)
+}
+
+func resourceDatasetHibernationRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	hibernatedAt, err := client.GetDatasetHibernatedAt(ctx, d.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read dataset hibernation",
+			Detail:   err.Error(),
+		})
+	}
+
+	if _, ok := d.GetOk("dataset"); !ok {
+		// only set on import, to avoid a diff on the dataset version
+		if err := d.Set("dataset", oid.DatasetOid(d.Id()).String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("hibernated", hibernatedAt != nil); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var hibernatedAtStr string
+	if hibernatedAt != nil {
+		hibernatedAtStr = hibernatedAt.String()
+	}
+	if err := d.Set("hibernated_at", hibernatedAtStr); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceDatasetHibernationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	// nothing to undo if the dataset was left awake
+	if !d.Get("hibernated").(bool) {
+		return diags
+	}
+
+	action := gql.HibernationActionTypeWakeup
+	if d.Get("wake_up_downstreams").(bool) {
+		action = gql.HibernationActionTypeWakeupincludingdownstreams
+	}
+
+	if _, err := applyDatasetHibernationAction(ctx, client, d.Id(), action); err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			return diags
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to wake up dataset",
+			Detail:   err.Error(),
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,124 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+)
+
+func TestAccObserveDatasetHibernation(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	datasetConfig := configPreamble + datastreamConfigPreamble + `
+		resource "observe_dataset" "first" {
+			workspace = data.observe_workspace.default.oid
+			name      = "%[1]s"
+
+			inputs = {
+				"test" = observe_datastream.test.dataset
+			}
+
+			stage {
+				pipeline = "filter true"
+			}
+		}
+	`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(datasetConfig+`
+					resource "observe_dataset_hibernation" "first" {
+						dataset = observe_dataset.first.oid
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_dataset_hibernation.first", "hibernated", "true"),
+					resource.TestCheckResourceAttrSet("observe_dataset_hibernation.first", "hibernated_at"),
+					resource.TestCheckResourceAttr("observe_dataset_hibernation.first", "affected_datasets.#", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(datasetConfig+`
+					resource "observe_dataset_hibernation" "first" {
+						dataset    = observe_dataset.first.oid
+						hibernated = false
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_dataset_hibernation.first", "hibernated", "false"),
+					resource.TestCheckResourceAttr("observe_dataset_hibernation.first", "hibernated_at", ""),
+				),
+			},
+			{
+				ResourceName:            "observe_dataset_hibernation.first",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dataset", "affected_datasets"},
+			},
+		},
+	})
+}
+
+func TestAccObserveDatasetHibernationDatasetDeleted(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	config := fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+		resource "observe_dataset" "first" {
+			workspace = data.observe_workspace.default.oid
+			name      = "%[1]s"
+
+			inputs = {
+				"test" = observe_datastream.test.dataset
+			}
+
+			stage {
+				pipeline = "filter true"
+			}
+		}
+
+		resource "observe_dataset_hibernation" "first" {
+			dataset = observe_dataset.first.oid
+		}
+	`, randomPrefix)
+
+	var datasetID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["observe_dataset_hibernation.first"]
+					if !ok {
+						return fmt.Errorf("observe_dataset_hibernation.first not found in state")
+					}
+					datasetID = rs.Primary.ID
+					return nil
+				},
+			},
+			{
+				// deleting the dataset outside of terraform should drop the
+				// hibernation from state on refresh rather than failing
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*observe.Client)
+					if err := client.DeleteDataset(context.Background(), datasetID); err != nil {
+						t.Fatalf("failed to delete dataset out-of-band: %s", err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,143 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceDatasetUnhealthyActions() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("dataset_unhealthy_actions", "description"),
+		CreateContext: resourceDatasetUnhealthyActionsCreate,
+		ReadContext:   resourceDatasetUnhealthyActionsRead,
+		UpdateContext: resourceDatasetUnhealthyActionsUpdate,
+		DeleteContext: resourceDatasetUnhealthyActionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				DiffSuppressFunc: diffSuppressOIDVersion,
+				Description:      descriptions.Get("dataset_unhealthy_actions", "schema", "dataset"),
+			},
+			"actions": unhealthyActionsSchema(),
+		},
+	}
+}
+
+// unhealthyActionsSchema is shared with observe_default_dataset_unhealthy_actions
+func unhealthyActionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validateOID(oid.TypeMonitorV2Action),
+		},
+		Description: descriptions.Get("dataset_unhealthy_actions", "schema", "actions"),
+	}
+}
+
+func newUnhealthyActionIds(d *schema.ResourceData) ([]string, diag.Diagnostics) {
+	actionIds := make([]string, 0)
+	for _, v := range d.Get("actions").(*schema.Set).List() {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		actionIds = append(actionIds, id.Id)
+	}
+	return actionIds, nil
+}
+
+func flattenUnhealthyActionIds(actionIds []string) []interface{} {
+	actions := make([]interface{}, 0, len(actionIds))
+	for _, id := range actionIds {
+		actions = append(actions, oid.MonitorV2ActionOid(id).String())
+	}
+	return actions
+}
+
+func resourceDatasetUnhealthyActionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	id, err := oid.NewOID(d.Get("dataset").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.Id)
+	return resourceDatasetUnhealthyActionsUpdate(ctx, d, m)
+}
+
+func resourceDatasetUnhealthyActionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	actionIds, diags := newUnhealthyActionIds(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := client.SetDatasetUnhealthyActions(ctx, d.Id(), actionIds); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set dataset unhealthy actions",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourceDatasetUnhealthyActionsRead(ctx, d, m)...)
+}
+
+func resourceDatasetUnhealthyActionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	actionIds, err := client.GetDatasetUnhealthyActions(ctx, d.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read dataset unhealthy actions",
+			Detail:   err.Error(),
+		})
+	}
+
+	if _, ok := d.GetOk("dataset"); !ok {
+		// only set on import, to avoid a diff on the dataset version
+		if err := d.Set("dataset", oid.DatasetOid(d.Id()).String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("actions", flattenUnhealthyActionIds(actionIds)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceDatasetUnhealthyActionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+	if _, err := client.SetDatasetUnhealthyActions(ctx, d.Id(), []string{}); err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			return diags
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to clear dataset unhealthy actions",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
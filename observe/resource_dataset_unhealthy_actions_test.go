@@ -0,0 +1,135 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+)
+
+var unhealthyActionsConfigPreamble = configPreamble + datastreamConfigPreamble + `
+	resource "observe_dataset" "first" {
+		workspace = data.observe_workspace.default.oid
+		name      = "%[1]s"
+
+		inputs = {
+			"test" = observe_datastream.test.dataset
+		}
+
+		stage {
+			pipeline = "filter true"
+		}
+	}
+
+	resource "observe_monitor_v2_action" "first" {
+		workspace = data.observe_workspace.default.oid
+		name      = "%[1]s-first"
+		type      = "email"
+		email {
+			subject   = "dataset unhealthy"
+			addresses = ["test@observeinc.com"]
+		}
+	}
+
+	resource "observe_monitor_v2_action" "second" {
+		workspace = data.observe_workspace.default.oid
+		name      = "%[1]s-second"
+		type      = "email"
+		email {
+			subject   = "dataset unhealthy"
+			addresses = ["test@observeinc.com"]
+		}
+	}
+`
+
+func TestAccObserveDatasetUnhealthyActions(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(unhealthyActionsConfigPreamble+`
+					resource "observe_dataset_unhealthy_actions" "first" {
+						dataset = observe_dataset.first.oid
+						actions = [observe_monitor_v2_action.first.oid]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_dataset_unhealthy_actions.first", "actions.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("observe_dataset_unhealthy_actions.first", "actions.*", "observe_monitor_v2_action.first", "oid"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(unhealthyActionsConfigPreamble+`
+					resource "observe_dataset_unhealthy_actions" "first" {
+						dataset = observe_dataset.first.oid
+						actions = [
+							observe_monitor_v2_action.first.oid,
+							observe_monitor_v2_action.second.oid,
+						]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_dataset_unhealthy_actions.first", "actions.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("observe_dataset_unhealthy_actions.first", "actions.*", "observe_monitor_v2_action.second", "oid"),
+				),
+			},
+			{
+				ResourceName:            "observe_dataset_unhealthy_actions.first",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dataset"},
+			},
+		},
+	})
+}
+
+func TestAccObserveDatasetUnhealthyActionsDatasetDeleted(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	config := fmt.Sprintf(unhealthyActionsConfigPreamble+`
+		resource "observe_dataset_unhealthy_actions" "first" {
+			dataset = observe_dataset.first.oid
+			actions = [observe_monitor_v2_action.first.oid]
+		}
+	`, randomPrefix)
+
+	var datasetID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources["observe_dataset_unhealthy_actions.first"]
+					if !ok {
+						return fmt.Errorf("observe_dataset_unhealthy_actions.first not found in state")
+					}
+					datasetID = rs.Primary.ID
+					return nil
+				},
+			},
+			{
+				// deleting the dataset outside of terraform should drop the
+				// bindings from state on refresh rather than failing
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*observe.Client)
+					if err := client.DeleteDataset(context.Background(), datasetID); err != nil {
+						t.Fatalf("failed to delete dataset out-of-band: %s", err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,660 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceDatasource() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("datasource", "description"),
+		CreateContext: resourceDatasourceCreate,
+		ReadContext:   resourceDatasourceRead,
+		UpdateContext: resourceDatasourceUpdate,
+		DeleteContext: resourceDatasourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				DiffSuppressFunc: diffSuppressWorkspace,
+				Deprecated:       "workspace is no longer required and will be ignored. It may be removed in a future version.",
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true, // Default folder when unset
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("common", "schema", "folder"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("datasource", "schema", "name"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("datasource", "schema", "description"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"data_connection": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataConnection),
+				Description:      descriptions.Get("datasource", "schema", "data_connection"),
+			},
+			"datastream": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDatastream),
+				Description:      descriptions.Get("datasource", "schema", "datastream"),
+			},
+			"datastream_token": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDatastreamToken),
+				Description:      descriptions.Get("datasource", "schema", "datastream_token"),
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateEnums(gql.AllDatasourceTypes),
+				DiffSuppressFunc: diffSuppressEnums,
+				Description:      describeEnums(gql.AllDatasourceTypes, descriptions.Get("datasource", "schema", "type")),
+			},
+			"variables": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ValidateDiagFunc: validateMapValues(validateIsString()),
+				Description:      descriptions.Get("datasource", "schema", "variables"),
+			},
+			"client_stack_attributes": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ValidateDiagFunc: validateMapValues(validateIsString()),
+				Description:      descriptions.Get("datasource", "schema", "client_stack_attributes"),
+			},
+			"aws_metrics_poller": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"aws_collection_stack"},
+				Description:   descriptions.Get("datasource", "schema", "aws_metrics_poller", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateTimeDuration,
+							DiffSuppressFunc: diffSuppressTimeDuration,
+							Description:      descriptions.Get("datasource", "schema", "aws_metrics_poller", "interval"),
+						},
+						"attach_resource_tags": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: descriptions.Get("datasource", "schema", "aws_metrics_poller", "attach_resource_tags"),
+						},
+						"metric": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: descriptions.Get("datasource", "schema", "aws_metrics_poller", "metric", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"namespace": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: descriptions.Get("datasource", "schema", "aws_metrics_poller", "metric", "namespace"),
+									},
+									"metric_names": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: descriptions.Get("datasource", "schema", "aws_metrics_poller", "metric", "metric_names"),
+									},
+									"tag_filter": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: descriptions.Get("datasource", "schema", "aws_metrics_poller", "metric", "tag_filter", "description"),
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: descriptions.Get("datasource", "schema", "aws_metrics_poller", "metric", "tag_filter", "key"),
+												},
+												"values": {
+													Type:        schema.TypeList,
+													Required:    true,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+													Description: descriptions.Get("datasource", "schema", "aws_metrics_poller", "metric", "tag_filter", "values"),
+												},
+											},
+										},
+									},
+									"dimension": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: descriptions.Get("datasource", "schema", "aws_metrics_poller", "metric", "dimension", "description"),
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: descriptions.Get("datasource", "schema", "aws_metrics_poller", "metric", "dimension", "name"),
+												},
+												"value": {
+													Type:        schema.TypeString,
+													Required:    true,
+													Description: descriptions.Get("datasource", "schema", "aws_metrics_poller", "metric", "dimension", "value"),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"aws_collection_stack": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"aws_metrics_poller"},
+				Description:   descriptions.Get("datasource", "schema", "aws_collection_stack", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"config_delivery_bucket_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("datasource", "schema", "aws_collection_stack", "config_delivery_bucket_name"),
+						},
+						"log_group_name_patterns": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("datasource", "schema", "aws_collection_stack", "log_group_name_patterns"),
+						},
+						"exclude_log_group_name_patterns": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("datasource", "schema", "aws_collection_stack", "exclude_log_group_name_patterns"),
+						},
+						"source_bucket_names": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("datasource", "schema", "aws_collection_stack", "source_bucket_names"),
+						},
+						"aws_service_metrics": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        resourceDatasourceAWSServiceMetrics(),
+							Description: descriptions.Get("datasource", "schema", "aws_collection_stack", "aws_service_metrics", "description"),
+						},
+						"custom_metrics": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        resourceDatasourceAWSServiceMetrics(),
+							Description: descriptions.Get("datasource", "schema", "aws_collection_stack", "custom_metrics", "description"),
+						},
+						"config_resource_list": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: descriptions.Get("datasource", "schema", "aws_collection_stack", "config_resource_list"),
+						},
+					},
+				},
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "state"),
+			},
+			"poller": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "poller"),
+			},
+			"filedrop_config": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: descriptions.Get("datasource", "schema", "filedrop_config", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filedrop": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("datasource", "schema", "filedrop_config", "filedrop"),
+						},
+						"destination_uri": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("datasource", "schema", "filedrop_config", "destination_uri"),
+						},
+						"data_access_point_arn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: descriptions.Get("datasource", "schema", "filedrop_config", "data_access_point_arn"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDatasourceAWSServiceMetrics() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("datasource", "schema", "aws_collection_stack", "aws_service_metrics", "namespace"),
+			},
+			"metric_names": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("datasource", "schema", "aws_collection_stack", "aws_service_metrics", "metric_names"),
+			},
+		},
+	}
+}
+
+func newAWSMetricsPollerConfigInput(d *schema.ResourceData) (*gql.AWSMetricsPollerConfigInput, diag.Diagnostics) {
+	interval, err := types.ParseDurationScalar(d.Get("aws_metrics_poller.0.interval").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	input := &gql.AWSMetricsPollerConfigInput{
+		Interval:                *interval,
+		CloudWatchMetricsConfig: make([]gql.AWSPollerMetricsInput, 0),
+	}
+
+	// always sent, so that unsetting the flag is not a no-op
+	input.AttachResourceTags = boolPtr(d.Get("aws_metrics_poller.0.attach_resource_tags").(bool))
+
+	for _, m := range d.Get("aws_metrics_poller.0.metric").([]interface{}) {
+		metric := m.(map[string]interface{})
+		metricInput := gql.AWSPollerMetricsInput{
+			Namespace:   metric["namespace"].(string),
+			MetricNames: makeStrSlice(metric["metric_names"].([]interface{})),
+		}
+		for _, f := range metric["tag_filter"].([]interface{}) {
+			filter := f.(map[string]interface{})
+			metricInput.TagFilters = append(metricInput.TagFilters, gql.TagFilterInput{
+				Key:    filter["key"].(string),
+				Values: makeStrSlice(filter["values"].([]interface{})),
+			})
+		}
+		for _, f := range metric["dimension"].([]interface{}) {
+			dimension := f.(map[string]interface{})
+			metricInput.Dimensions = append(metricInput.Dimensions, gql.DimensionFilterInput{
+				Name:  dimension["name"].(string),
+				Value: dimension["value"].(string),
+			})
+		}
+		input.CloudWatchMetricsConfig = append(input.CloudWatchMetricsConfig, metricInput)
+	}
+
+	return input, nil
+}
+
+func newAWSServiceMetricsInputs(in []interface{}) (metrics []gql.AWSServiceMetricsInput) {
+	for _, m := range in {
+		metric := m.(map[string]interface{})
+		metrics = append(metrics, gql.AWSServiceMetricsInput{
+			Namespace:   metric["namespace"].(string),
+			MetricNames: makeStrSlice(metric["metric_names"].([]interface{})),
+		})
+	}
+	return metrics
+}
+
+func newAWSCollectionStackConfigInput(d *schema.ResourceData) *gql.AWSCollectionStackConfigInput {
+	input := &gql.AWSCollectionStackConfigInput{
+		LogGroupNamePatterns:        makeStrSlice(d.Get("aws_collection_stack.0.log_group_name_patterns").([]interface{})),
+		ExcludeLogGroupNamePatterns: makeStrSlice(d.Get("aws_collection_stack.0.exclude_log_group_name_patterns").([]interface{})),
+		SourceBucketNames:           makeStrSlice(d.Get("aws_collection_stack.0.source_bucket_names").([]interface{})),
+		AwsServiceMetricsList:       newAWSServiceMetricsInputs(d.Get("aws_collection_stack.0.aws_service_metrics").([]interface{})),
+		CustomMetricsList:           newAWSServiceMetricsInputs(d.Get("aws_collection_stack.0.custom_metrics").([]interface{})),
+		ConfigResourceList:          makeStrSlice(d.Get("aws_collection_stack.0.config_resource_list").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("aws_collection_stack.0.config_delivery_bucket_name"); ok {
+		input.ConfigDeliveryBucketName = stringPtr(v.(string))
+	}
+
+	return input
+}
+
+func newDatasourceInput(d *schema.ResourceData) (*gql.DatasourceInput, diag.Diagnostics) {
+	connectionId, err := oid.NewOID(d.Get("data_connection").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	datastreamId, err := oid.NewOID(d.Get("datastream").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	input := &gql.DatasourceInput{
+		Name:                  d.Get("name").(string),
+		DataConnectionID:      connectionId.Id,
+		DatastreamID:          datastreamId.Id,
+		Variables:             makeDataVariableInputs(d.Get("variables").(map[string]interface{})),
+		ClientStackAttributes: makeDataVariableInputs(d.Get("client_stack_attributes").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("folder"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		input.FolderId = id.Version
+	}
+
+	if v, ok := d.GetOk("datastream_token"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		input.DatastreamTokenID = &id.Id
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		datasourceType := gql.DatasourceType(toCamel(v.(string)))
+		input.Type = &datasourceType
+	}
+
+	if _, ok := d.GetOk("aws_metrics_poller"); ok {
+		pollerConfig, diags := newAWSMetricsPollerConfigInput(d)
+		if diags.HasError() {
+			return nil, diags
+		}
+		input.Config = &gql.DatasourceConfigInput{AwsMetricsPollerConfig: pollerConfig}
+	}
+
+	if _, ok := d.GetOk("aws_collection_stack"); ok {
+		input.Config = &gql.DatasourceConfigInput{AwsCollectionStackConfig: newAWSCollectionStackConfigInput(d)}
+	}
+
+	return input, nil
+}
+
+func resourceDatasourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	wsid, err := client.ResolveWorkspaceID(ctx, maybeString(d.GetOk("workspace")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input, diags := newDatasourceInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	result, err := client.CreateDatasource(ctx, wsid, input)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create datasource",
+			Detail:   err.Error(),
+		})
+	}
+
+	d.SetId(result.Id)
+	return append(diags, resourceDatasourceRead(ctx, d, m)...)
+}
+
+func resourceDatasourceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	input, diags := newDatasourceInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := client.UpdateDatasource(ctx, d.Id(), input); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update datasource",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourceDatasourceRead(ctx, d, m)...)
+}
+
+func flattenAWSServiceMetrics(in []gql.AWSServiceMetrics) []interface{} {
+	metrics := make([]interface{}, 0, len(in))
+	for _, metric := range in {
+		metrics = append(metrics, map[string]interface{}{
+			"namespace":    metric.Namespace,
+			"metric_names": metric.MetricNames,
+		})
+	}
+	return metrics
+}
+
+func flattenAWSMetricsPollerConfig(c *gql.DatasourceMetricsPollerConfig) []interface{} {
+	metrics := make([]interface{}, 0, len(c.Queries))
+	for _, query := range c.Queries {
+		var tagFilters []interface{}
+		if query.ResourceFilter != nil {
+			for _, filter := range query.ResourceFilter.TagFilters {
+				tagFilters = append(tagFilters, map[string]interface{}{
+					"key":    filter.Key,
+					"values": filter.Values,
+				})
+			}
+		}
+		var dimensions []interface{}
+		for _, dimension := range query.Dimensions {
+			var value string
+			if dimension.Value != nil {
+				value = *dimension.Value
+			}
+			dimensions = append(dimensions, map[string]interface{}{
+				"name":  dimension.Name,
+				"value": value,
+			})
+		}
+		metrics = append(metrics, map[string]interface{}{
+			"namespace":    query.Namespace,
+			"metric_names": query.MetricNames,
+			"tag_filter":   tagFilters,
+			"dimension":    dimensions,
+		})
+	}
+
+	config := map[string]interface{}{
+		"attach_resource_tags": c.AttachResourceTags,
+		"metric":               metrics,
+	}
+	if c.Interval != nil {
+		config["interval"] = c.Interval.String()
+	}
+	return []interface{}{config}
+}
+
+func resourceDatasourceRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	datasource, err := client.GetDatasource(ctx, d.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read datasource",
+			Detail:   err.Error(),
+		})
+	}
+
+	if err := d.Set("oid", datasource.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("name", datasource.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if datasource.Description != nil {
+		if err := d.Set("description", *datasource.Description); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if datasource.IconUrl != nil {
+		if err := d.Set("icon_url", *datasource.IconUrl); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("workspace", oid.WorkspaceOid(datasource.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("folder", oid.FolderOid(datasource.FolderId, datasource.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	connectionOid := oid.OID{Type: oid.TypeDataConnection, Id: datasource.DataConnectionID}
+	if err := d.Set("data_connection", connectionOid.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("datastream", oid.DatastreamOid(datasource.DatastreamID).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if datasource.DatastreamTokenID != nil {
+		if err := d.Set("datastream_token", oid.DatastreamTokenOid(*datasource.DatastreamTokenID).String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if datasource.Type != nil {
+		if err := d.Set("type", toSnake(string(*datasource.Type))); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("state", toSnake(string(datasource.Status.State))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("variables", flattenDataVariables(datasource.Variables)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("client_stack_attributes", flattenDataVariables(datasource.ClientStackAttributes)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var (
+		poller         string
+		filedropConfig []interface{}
+	)
+
+	if config := datasource.Config; config != nil {
+		if c := config.AwsMetricsPollerConfig; c != nil {
+			poller = oid.PollerOid(c.Poller.Id).String()
+
+			// the metrics poller config is read back from the poller it creates
+			if pc, ok := c.Poller.Config.(*gql.DatasourceConfigAwsMetricsPollerConfigAWSMetricsPollerConfigPollerConfigPollerCloudWatchMetricsConfig); ok {
+				if err := d.Set("aws_metrics_poller", flattenAWSMetricsPollerConfig(&pc.DatasourceMetricsPollerConfig)); err != nil {
+					diags = append(diags, diag.FromErr(err)...)
+				}
+			}
+		}
+
+		if c := config.DatasourceFiledropConfig; c != nil {
+			filedropOid := oid.OID{Type: oid.TypeFiledrop, Id: c.Filedrop.Id}
+			filedropConfig = append(filedropConfig, map[string]interface{}{
+				"filedrop":              filedropOid.String(),
+				"destination_uri":       c.DestinationUri,
+				"data_access_point_arn": c.DataAccessPointArn,
+			})
+		}
+
+		if c := config.AwsCollectionStackConfig; c != nil {
+			stack := map[string]interface{}{
+				"config_delivery_bucket_name":     c.ConfigDeliveryBucketName,
+				"log_group_name_patterns":         c.LogGroupNamePatterns,
+				"exclude_log_group_name_patterns": c.ExcludeLogGroupNamePatterns,
+				"source_bucket_names":             c.SourceBucketNames,
+				"aws_service_metrics":             flattenAWSServiceMetrics(c.AwsServiceMetricsList),
+				"custom_metrics":                  flattenAWSServiceMetrics(c.CustomMetricsList),
+				"config_resource_list":            c.ConfigResourceList,
+			}
+			if err := d.Set("aws_collection_stack", []interface{}{stack}); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+	}
+
+	if err := d.Set("poller", poller); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("filedrop_config", filedropConfig); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceDatasourceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+	if err := client.DeleteDatasource(ctx, d.Id()); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete datasource",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
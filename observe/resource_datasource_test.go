@@ -0,0 +1,159 @@
+package observe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveDatasource(t *testing.T) {
+	moduleID := os.Getenv("OBSERVE_DATA_CONNECTION_MODULE_ID")
+	if moduleID == "" {
+		t.Skip("OBSERVE_DATA_CONNECTION_MODULE_ID must be set to create a data connection.")
+	}
+
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_datastream" "example" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+					}
+
+					resource "observe_data_connection" "example" {
+						name      = "%[1]s"
+						module_id = "%[2]s"
+					}
+
+					resource "observe_datasource" "example" {
+						name            = "%[1]s"
+						data_connection = observe_data_connection.example.oid
+						datastream      = observe_datastream.example.oid
+
+						aws_metrics_poller {
+							interval             = "5m"
+							attach_resource_tags = true
+
+							metric {
+								namespace    = "AWS/EC2"
+								metric_names = ["CPUUtilization"]
+
+								dimension {
+									name  = "InstanceType"
+									value = "t3.micro"
+								}
+							}
+						}
+					}
+				`, randomPrefix, moduleID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_datasource.example", "oid"),
+					resource.TestCheckResourceAttr("observe_datasource.example", "name", randomPrefix),
+					resource.TestCheckResourceAttrPair("observe_datasource.example", "data_connection", "observe_data_connection.example", "oid"),
+					resource.TestCheckResourceAttrPair("observe_datasource.example", "datastream", "observe_datastream.example", "oid"),
+					resource.TestCheckResourceAttrSet("observe_datasource.example", "state"),
+					resource.TestCheckResourceAttr("observe_datasource.example", "aws_metrics_poller.0.attach_resource_tags", "true"),
+					resource.TestCheckResourceAttr("observe_datasource.example", "aws_metrics_poller.0.metric.0.namespace", "AWS/EC2"),
+					resource.TestCheckResourceAttr("observe_datasource.example", "aws_metrics_poller.0.metric.0.dimension.0.value", "t3.micro"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_datastream" "example" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+					}
+
+					resource "observe_data_connection" "example" {
+						name      = "%[1]s"
+						module_id = "%[2]s"
+					}
+
+					resource "observe_datasource" "example" {
+						name            = "%[1]s"
+						data_connection = observe_data_connection.example.oid
+						datastream      = observe_datastream.example.oid
+
+						aws_metrics_poller {
+							interval = "5m"
+
+							metric {
+								namespace    = "AWS/EC2"
+								metric_names = ["CPUUtilization"]
+
+								dimension {
+									name  = "InstanceType"
+									value = "t3.micro"
+								}
+							}
+						}
+					}
+				`, randomPrefix, moduleID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_datasource.example", "aws_metrics_poller.0.attach_resource_tags", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_datastream" "example" {
+						workspace = data.observe_workspace.default.oid
+						name      = "%[1]s"
+					}
+
+					resource "observe_data_connection" "example" {
+						name      = "%[1]s"
+						module_id = "%[2]s"
+					}
+
+					resource "observe_datasource" "example" {
+						name            = "%[1]s"
+						description     = "collection stack"
+						data_connection = observe_data_connection.example.oid
+						datastream      = observe_datastream.example.oid
+
+						variables = {
+							environment = "test"
+						}
+
+						client_stack_attributes = {
+							accountId = "123456789012"
+							region    = "us-west-2"
+						}
+
+						aws_collection_stack {
+							log_group_name_patterns = ["/aws/lambda/*"]
+							config_resource_list    = ["AWS::EC2::Instance"]
+
+							aws_service_metrics {
+								namespace    = "AWS/Lambda"
+								metric_names = ["Invocations", "Errors"]
+							}
+						}
+					}
+				`, randomPrefix, moduleID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_datasource.example", "description", "collection stack"),
+					resource.TestCheckResourceAttr("observe_datasource.example", "aws_collection_stack.0.log_group_name_patterns.0", "/aws/lambda/*"),
+					resource.TestCheckResourceAttr("observe_datasource.example", "aws_collection_stack.0.aws_service_metrics.0.namespace", "AWS/Lambda"),
+					resource.TestCheckResourceAttr("observe_datasource.example", "aws_collection_stack.0.aws_service_metrics.0.metric_names.#", "2"),
+					resource.TestCheckResourceAttr("observe_datasource.example", "variables.environment", "test"),
+					resource.TestCheckResourceAttr("observe_datasource.example", "client_stack_attributes.%", "2"),
+					resource.TestCheckResourceAttr("observe_datasource.example", "client_stack_attributes.region", "us-west-2"),
+				),
+			},
+			{
+				ResourceName:      "observe_datasource.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
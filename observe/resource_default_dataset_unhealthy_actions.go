@@ -0,0 +1,82 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceDefaultDatasetUnhealthyActions() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("dataset_unhealthy_actions", "default_description"),
+		CreateContext: resourceDefaultDatasetUnhealthyActionsCreate,
+		ReadContext:   resourceDefaultDatasetUnhealthyActionsRead,
+		UpdateContext: resourceDefaultDatasetUnhealthyActionsUpdate,
+		DeleteContext: resourceDefaultDatasetUnhealthyActionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"actions": unhealthyActionsSchema(),
+		},
+	}
+}
+
+func resourceDefaultDatasetUnhealthyActionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	// we just set a constant id since there's only one of this config per tenant
+	d.SetId("default_dataset_unhealthy_actions")
+	return resourceDefaultDatasetUnhealthyActionsUpdate(ctx, d, m)
+}
+
+func resourceDefaultDatasetUnhealthyActionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	actionIds, diags := newUnhealthyActionIds(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := client.SetDefaultDatasetUnhealthyActions(ctx, actionIds); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set default dataset unhealthy actions",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourceDefaultDatasetUnhealthyActionsRead(ctx, d, m)...)
+}
+
+func resourceDefaultDatasetUnhealthyActionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	actionIds, err := client.GetDefaultDatasetUnhealthyActions(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read default dataset unhealthy actions",
+			Detail:   err.Error(),
+		})
+	}
+
+	if err := d.Set("actions", flattenUnhealthyActionIds(actionIds)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceDefaultDatasetUnhealthyActionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+	if _, err := client.SetDefaultDatasetUnhealthyActions(ctx, []string{}); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to clear default dataset unhealthy actions",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,42 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveDefaultDatasetUnhealthyActions(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	// not parallel, since the defaults are shared by the whole customer
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(unhealthyActionsConfigPreamble+`
+					resource "observe_default_dataset_unhealthy_actions" "default" {
+						actions = [observe_monitor_v2_action.first.oid]
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_default_dataset_unhealthy_actions.default", "actions.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("observe_default_dataset_unhealthy_actions.default", "actions.*", "observe_monitor_v2_action.first", "oid"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(unhealthyActionsConfigPreamble+`
+					resource "observe_default_dataset_unhealthy_actions" "default" {
+						actions = []
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_default_dataset_unhealthy_actions.default", "actions.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,98 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceDefaultInstanceDashboard() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("default_instance_dashboard", "description"),
+		CreateContext: resourceDefaultInstanceDashboardSet,
+		UpdateContext: resourceDefaultInstanceDashboardSet,
+		ReadContext:   resourceDefaultInstanceDashboardRead,
+		DeleteContext: resourceDefaultInstanceDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"dataset": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDataset),
+				DiffSuppressFunc: diffSuppressOIDVersion,
+				Description:      descriptions.Get("default_instance_dashboard", "schema", "dataset"),
+			},
+			"dashboard": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDashboard),
+				Description:      descriptions.Get("default_instance_dashboard", "schema", "dashboard"),
+			},
+		},
+	}
+}
+
+func resourceDefaultInstanceDashboardSet(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	dsid, _ := oid.NewOID(data.Get("dataset").(string))
+	dashid, _ := oid.NewOID(data.Get("dashboard").(string))
+
+	err := client.SetDefaultInstanceDashboard(ctx, dsid.Id, dashid.Id)
+	if err != nil {
+		return diag.Errorf("failed to set default instance dashboard: %s", err.Error())
+	}
+
+	data.SetId(dsid.Id)
+
+	return append(diags, resourceDefaultInstanceDashboardRead(ctx, data, meta)...)
+}
+
+func resourceDefaultInstanceDashboardRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	dashid, err := client.GetDefaultInstanceDashboard(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read default instance dashboard: %s", err.Error())
+	}
+
+	if dashid == nil {
+		// cleared outside of terraform
+		data.SetId("")
+		return nil
+	}
+
+	if _, ok := data.GetOk("dataset"); !ok {
+		// only set on import, to avoid a diff on the dataset version
+		if err := data.Set("dataset", oid.DatasetOid(data.Id()).String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := data.Set("dashboard", oid.DashboardOid(*dashid).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceDefaultInstanceDashboardDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if err := client.ClearDefaultInstanceDashboard(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to delete default instance dashboard: %s", err.Error())
+	}
+	return diags
+}
@@ -0,0 +1,76 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var defaultInstanceDashboardConfigPreamble = datastreamConfigPreamble + `
+		data "observe_oid" "dataset" {
+			oid = observe_datastream.test.dataset
+		}
+
+		resource "observe_dashboard" "test" {
+			workspace = data.observe_workspace.default.oid
+			name      = "%[1]s"
+			stages = <<-EOF
+			[{
+				"pipeline": "",
+				"input": [{
+				"inputName": "test",
+				"inputRole": "Data",
+				"datasetId": "${data.observe_oid.dataset.id}"
+				}]
+			}]
+			EOF
+		}`
+
+// Verify we can set default instance dashboards, read them back, and then delete them
+func TestAccObserveDefaultInstanceDashboard(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+defaultInstanceDashboardConfigPreamble+`
+				resource "observe_default_instance_dashboard" "example" {
+					dataset   = observe_datastream.test.dataset
+					dashboard = observe_dashboard.test.oid
+				}
+
+				data "observe_default_instance_dashboard" "example" {
+					dataset    = observe_datastream.test.dataset
+					depends_on = [observe_default_instance_dashboard.example]
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("observe_default_instance_dashboard.example", "dashboard", "observe_dashboard.test", "oid"),
+					resource.TestCheckResourceAttrPair("data.observe_default_instance_dashboard.example", "dashboard", "observe_dashboard.test", "oid"),
+				),
+			},
+			{
+				ResourceName:      "observe_default_instance_dashboard.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"dataset",
+				},
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+defaultInstanceDashboardConfigPreamble+`
+				data "observe_default_instance_dashboard" "example" {
+					dataset = observe_datastream.test.dataset
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.observe_default_instance_dashboard.example", "dashboard"),
+				),
+			},
+		},
+	})
+}
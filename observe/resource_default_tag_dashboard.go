@@ -0,0 +1,85 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceDefaultTagDashboard() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("default_tag_dashboard", "description"),
+		CreateContext: resourceDefaultTagDashboardSet,
+		UpdateContext: resourceDefaultTagDashboardSet,
+		ReadContext:   resourceDefaultTagDashboardRead,
+		DeleteContext: resourceDefaultTagDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: descriptions.Get("default_tag_dashboard", "schema", "tag"),
+			},
+			"dashboard": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeDashboard),
+				Description:      descriptions.Get("default_tag_dashboard", "schema", "dashboard"),
+			},
+		},
+	}
+}
+
+func resourceDefaultTagDashboardSet(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	tag := data.Get("tag").(string)
+	dashid, _ := oid.NewOID(data.Get("dashboard").(string))
+
+	err := client.SetDefaultDashboardForTag(ctx, tag, dashid.Id)
+	if err != nil {
+		return diag.Errorf("failed to set default dashboard for tag: %s", err.Error())
+	}
+
+	data.SetId(tag)
+
+	return append(diags, resourceDefaultTagDashboardRead(ctx, data, meta)...)
+}
+
+func resourceDefaultTagDashboardRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	dashid, err := client.GetDefaultDashboardForTag(ctx, data.Id())
+	if err != nil {
+		return diag.Errorf("failed to read default dashboard for tag: %s", err.Error())
+	}
+
+	if dashid == nil {
+		data.SetId("")
+		return nil
+	}
+
+	if err := data.Set("tag", data.Id()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("dashboard", oid.DashboardOid(*dashid).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceDefaultTagDashboardDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	// there is no mutation to clear the default dashboard for a tag, so the
+	// resource is only removed from state.
+	return diags
+}
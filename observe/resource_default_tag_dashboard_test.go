@@ -0,0 +1,49 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveDefaultTagDashboard(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+defaultInstanceDashboardConfigPreamble+`
+				resource "observe_default_tag_dashboard" "example" {
+					tag       = "%[1]s.service.name"
+					dashboard = observe_dashboard.test.oid
+				}
+
+				data "observe_default_tag_dashboard" "by_tag" {
+					tag = observe_default_tag_dashboard.example.tag
+				}
+
+				data "observe_default_tag_dashboard" "by_dashboard" {
+					dashboard  = observe_dashboard.test.oid
+					depends_on = [observe_default_tag_dashboard.example]
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_default_tag_dashboard.example", "tag", randomPrefix+".service.name"),
+					resource.TestCheckResourceAttrPair("observe_default_tag_dashboard.example", "dashboard", "observe_dashboard.test", "oid"),
+					resource.TestCheckResourceAttrPair("data.observe_default_tag_dashboard.by_tag", "dashboard", "observe_dashboard.test", "oid"),
+					resource.TestCheckResourceAttr("data.observe_default_tag_dashboard.by_dashboard", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.observe_default_tag_dashboard.by_dashboard", "tags.0", randomPrefix+".service.name"),
+				),
+			},
+			{
+				ResourceName:      "observe_default_tag_dashboard.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
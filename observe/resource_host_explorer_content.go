@@ -0,0 +1,102 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceHostExplorerContent() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("host_explorer_content", "description"),
+		CreateContext: resourceHostExplorerContentCreate,
+		ReadContext:   resourceHostExplorerContentRead,
+		UpdateContext: resourceHostExplorerContentUpdate,
+		DeleteContext: resourceHostExplorerContentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"otel_logs_dataset":          contentSourceDatasetSchema(descriptions.Get("host_explorer_content", "schema", "otel_logs_dataset"), false),
+			"prometheus_dataset":         contentSourceDatasetSchema(descriptions.Get("host_explorer_content", "schema", "prometheus_dataset"), false),
+			"host_explorer_logs_dataset": contentDatasetSchema(descriptions.Get("host_explorer_content", "schema", "host_explorer_logs_dataset")),
+		},
+	}
+}
+
+func newHostExplorerContentInput(d *schema.ResourceData) (input *gql.HostExplorerContentInput, diags diag.Diagnostics) {
+	input = &gql.HostExplorerContentInput{}
+	var err error
+	if input.OtelLogsDatasetId, err = contentDatasetId(d, "otel_logs_dataset"); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if input.PrometheusDatasetId, err = contentDatasetId(d, "prometheus_dataset"); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return input, nil
+}
+
+func resourceHostExplorerContentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	// we just set a constant id since there's only one of this content per tenant
+	d.SetId("host_explorer_content")
+	return resourceHostExplorerContentUpdate(ctx, d, m)
+}
+
+func resourceHostExplorerContentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	input, diags := newHostExplorerContentInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := client.UpdateHostExplorerContent(ctx, input); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update host explorer content",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourceHostExplorerContentRead(ctx, d, m)...)
+}
+
+func resourceHostExplorerContentRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	content, err := client.GetHostExplorerContent(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read host explorer content",
+			Detail:   err.Error(),
+		})
+	}
+
+	if content == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setContentDatasets(d, map[string]*string{
+		"otel_logs_dataset":          content.OtelLogsDatasetId,
+		"prometheus_dataset":         content.PrometheusDatasetId,
+		"host_explorer_logs_dataset": content.HostExplorerLogsDatasetId,
+	})
+}
+
+func resourceHostExplorerContentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+	if err := client.DeleteHostExplorerContent(ctx); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete host explorer content",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,32 @@
+package observe
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveHostExplorerContent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configPreamble + `
+				resource "observe_host_explorer_content" "default" {}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_host_explorer_content.default", "id", "host_explorer_content"),
+					resource.TestCheckResourceAttrSet("observe_host_explorer_content.default", "prometheus_dataset"),
+					resource.TestCheckResourceAttrSet("observe_host_explorer_content.default", "host_explorer_logs_dataset"),
+				),
+			},
+			{
+				ResourceName:      "observe_host_explorer_content.default",
+				ImportState:       true,
+				ImportStateId:     "host_explorer_content",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
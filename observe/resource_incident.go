@@ -0,0 +1,314 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceIncident() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("incident", "description"),
+		CreateContext: resourceIncidentCreate,
+		ReadContext:   resourceIncidentRead,
+		UpdateContext: resourceIncidentUpdate,
+		DeleteContext: resourceIncidentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				DiffSuppressFunc: diffSuppressWorkspace,
+				Deprecated:       "workspace is no longer required and will be ignored. It may be removed in a future version.",
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true, // Default folder when unset
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("common", "schema", "folder"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("incident", "schema", "name"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("incident", "schema", "description"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          toSnake(string(gql.IncidentStatusActive)),
+				ValidateDiagFunc: validateEnums(gql.AllIncidentStatuses),
+				DiffSuppressFunc: diffSuppressEnums,
+				Description:      describeEnums(gql.AllIncidentStatuses, descriptions.Get("incident", "schema", "status")),
+			},
+			"slack_channel": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        resourceIncidentSlackChannel(),
+				Description: descriptions.Get("incident", "schema", "slack_channel", "description"),
+			},
+			"inactive_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "inactive_time"),
+			},
+			"closed_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("incident", "schema", "closed_time"),
+			},
+		},
+	}
+}
+
+func resourceIncidentSlackChannel() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("incident", "schema", "slack_channel", "connection_id"),
+			},
+			"channel_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("incident", "schema", "slack_channel", "channel_id"),
+			},
+		},
+	}
+}
+
+func newIncidentInput(d *schema.ResourceData) (*gql.IncidentInput, diag.Diagnostics) {
+	input := &gql.IncidentInput{
+		Name:   d.Get("name").(string),
+		Status: gql.IncidentStatus(toCamel(d.Get("status").(string))),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("folder"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		input.FolderId = id.Version
+	}
+
+	return input, nil
+}
+
+func newIncidentSlackChannelInputs(channels *schema.Set) []gql.IncidentSlackchannelInput {
+	inputs := make([]gql.IncidentSlackchannelInput, 0, channels.Len())
+	for _, v := range channels.List() {
+		channel := v.(map[string]interface{})
+		inputs = append(inputs, gql.IncidentSlackchannelInput{
+			ConnectionID:   channel["connection_id"].(string),
+			SlackchannelID: channel["channel_id"].(string),
+		})
+	}
+	return inputs
+}
+
+// updateIncidentSlackChannels binds and unbinds slack channels so that the
+// incident matches newChannels.
+func updateIncidentSlackChannels(ctx context.Context, client *observe.Client, id string, oldChannels, newChannels *schema.Set) error {
+	if remove := newIncidentSlackChannelInputs(oldChannels.Difference(newChannels)); len(remove) > 0 {
+		if _, err := client.RemoveIncidentSlackChannels(ctx, id, remove); err != nil {
+			return err
+		}
+	}
+
+	if add := newIncidentSlackChannelInputs(newChannels.Difference(oldChannels)); len(add) > 0 {
+		if _, err := client.AddIncidentSlackChannels(ctx, id, add); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceIncidentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	wsid, err := client.ResolveWorkspaceID(ctx, maybeString(d.GetOk("workspace")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input, diags := newIncidentInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	result, err := client.CreateIncident(ctx, wsid, input)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create incident",
+			Detail:   err.Error(),
+		})
+	}
+
+	d.SetId(result.Id)
+
+	emptySet := schema.NewSet(schema.HashResource(resourceIncidentSlackChannel()), []interface{}{})
+	if err := updateIncidentSlackChannels(ctx, client, d.Id(), emptySet, d.Get("slack_channel").(*schema.Set)); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to add incident slack channels",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourceIncidentRead(ctx, d, m)...)
+}
+
+func resourceIncidentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	input, diags := newIncidentInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := client.UpdateIncident(ctx, d.Id(), input); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update incident",
+			Detail:   err.Error(),
+		})
+	}
+
+	if d.HasChange("slack_channel") {
+		old, new := d.GetChange("slack_channel")
+		if err := updateIncidentSlackChannels(ctx, client, d.Id(), old.(*schema.Set), new.(*schema.Set)); err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update incident slack channels",
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return append(diags, resourceIncidentRead(ctx, d, m)...)
+}
+
+func resourceIncidentRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	incident, err := client.GetIncident(ctx, d.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read incident",
+			Detail:   err.Error(),
+		})
+	}
+
+	if err := d.Set("oid", incident.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("name", incident.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if incident.Description != nil {
+		if err := d.Set("description", *incident.Description); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if incident.IconUrl != nil {
+		if err := d.Set("icon_url", *incident.IconUrl); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("workspace", oid.WorkspaceOid(incident.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("folder", oid.FolderOid(incident.FolderId, incident.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("status", toSnake(string(incident.Status))); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	channels := make([]interface{}, 0, len(incident.SlackChannels))
+	for _, channel := range incident.SlackChannels {
+		channels = append(channels, map[string]interface{}{
+			"connection_id": channel.ConnectionID,
+			"channel_id":    channel.SlackchannelID,
+		})
+	}
+	if err := d.Set("slack_channel", channels); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var inactiveTime string
+	if incident.InactiveTime != nil {
+		inactiveTime = incident.InactiveTime.String()
+	}
+	if err := d.Set("inactive_time", inactiveTime); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var closedTime string
+	if incident.ClosedTime != nil {
+		closedTime = incident.ClosedTime.String()
+	}
+	if err := d.Set("closed_time", closedTime); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceIncidentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+	if err := client.DeleteIncident(ctx, d.Id()); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete incident",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,102 @@
+package observe
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveIncident(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_incident" "game_day" {
+						name        = "%[1]s"
+						description = "ingest outage game day"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_incident.game_day", "workspace"),
+					resource.TestCheckResourceAttrSet("observe_incident.game_day", "folder"),
+					resource.TestCheckResourceAttrSet("observe_incident.game_day", "oid"),
+					resource.TestCheckResourceAttr("observe_incident.game_day", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_incident.game_day", "description", "ingest outage game day"),
+					resource.TestCheckResourceAttr("observe_incident.game_day", "status", "active"),
+					resource.TestCheckResourceAttr("observe_incident.game_day", "slack_channel.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_incident" "game_day" {
+						name        = "%[1]s"
+						description = "ingest outage game day"
+						status      = "closed"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_incident.game_day", "status", "closed"),
+					resource.TestCheckResourceAttrSet("observe_incident.game_day", "closed_time"),
+				),
+			},
+			{
+				ResourceName:      "observe_incident.game_day",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccObserveIncidentSlackChannel(t *testing.T) {
+	connectionID := os.Getenv("OBSERVE_SLACK_CONNECTION_ID")
+	channelID := os.Getenv("OBSERVE_SLACK_CHANNEL_ID")
+	if connectionID == "" || channelID == "" {
+		t.Skip("OBSERVE_SLACK_CONNECTION_ID and OBSERVE_SLACK_CHANNEL_ID must be set to bind an incident to a slack channel.")
+	}
+
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_incident" "game_day" {
+						name = "%[1]s"
+
+						slack_channel {
+							connection_id = "%[2]s"
+							channel_id    = "%[3]s"
+						}
+					}
+				`, randomPrefix, connectionID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_incident.game_day", "slack_channel.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("observe_incident.game_day", "slack_channel.*", map[string]string{
+						"connection_id": connectionID,
+						"channel_id":    channelID,
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_incident" "game_day" {
+						name = "%[1]s"
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_incident.game_day", "slack_channel.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -61,6 +61,15 @@ func resourceIngestToken() *schema.Resource {
 				Computed:    true,
 				Description: descriptions.Get("ingest_token", "schema", "secret"),
 			},
+			"datastreams": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeDatastream),
+				},
+				Description: descriptions.Get("ingest_token", "schema", "datastreams"),
+			},
 		},
 	}
 }
@@ -89,6 +98,33 @@ func ingestTokenInputFromResource(data *schema.ResourceData) gql.IngestTokenInpu
 	}
 }
 
+func ingestTokenDatastreamIds(data *schema.ResourceData) ([]string, error) {
+	var ids []string
+	for _, v := range data.Get("datastreams").(*schema.Set).List() {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id.Id)
+	}
+	return ids, nil
+}
+
+func updateIngestTokenAssociation(ctx context.Context, client *observe.Client, data *schema.ResourceData) (diags diag.Diagnostics) {
+	ids, err := ingestTokenDatastreamIds(data)
+	if err == nil {
+		err = client.UpdateIngestTokenAssociation(ctx, data.Id(), ids)
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("failed to update ingest token datastreams [id=%s]", data.Id()),
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
+
 func ingestTokenToResourceData(ingestToken *gql.IngestToken, data *schema.ResourceData) (diags diag.Diagnostics) {
 	if err := data.Set("workspace", oid.WorkspaceOid(ingestToken.WorkspaceId).String()); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
@@ -154,6 +190,11 @@ func resourceIngestTokenCreate(ctx context.Context, data *schema.ResourceData, m
 		diags = append(diags, diag.FromErr(err)...)
 		return diags
 	}
+	if _, ok := data.GetOk("datastreams"); ok {
+		if diags = updateIngestTokenAssociation(ctx, client, data); diags.HasError() {
+			return diags
+		}
+	}
 
 	return append(diags, resourceIngestTokenRead(ctx, data, meta)...)
 }
@@ -188,6 +229,13 @@ func resourceIngestTokenUpdate(ctx context.Context, data *schema.ResourceData, m
 		})
 		return diags
 	}
+	// associations can not be read back, so we only send them when the
+	// configuration changes
+	if data.HasChange("datastreams") {
+		if diags = updateIngestTokenAssociation(ctx, client, data); diags.HasError() {
+			return diags
+		}
+	}
 
 	return ingestTokenToResourceData(ingestToken, data)
 }
@@ -1,9 +1,11 @@
 package observe
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -98,3 +100,71 @@ func TestIngestTokenDisabled(t *testing.T) {
 		},
 	})
 }
+
+func TestIngestTokenDatastreams(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_datastream" "a" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-a"
+				}
+
+				resource "observe_datastream" "b" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-b"
+				}
+
+				resource "observe_ingest_token" "example" {
+					workspace   = data.observe_workspace.default.oid
+					name        = "%[1]s"
+					datastreams = [observe_datastream.a.oid]
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_ingest_token.example", "datastreams.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("observe_ingest_token.example", "datastreams.*", "observe_datastream.a", "oid"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_datastream" "a" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-a"
+				}
+
+				resource "observe_datastream" "b" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s-b"
+				}
+
+				resource "observe_ingest_token" "example" {
+					workspace   = data.observe_workspace.default.oid
+					name        = "%[1]s"
+					datastreams = [observe_datastream.a.oid, observe_datastream.b.oid]
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_ingest_token.example", "datastreams.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("observe_ingest_token.example", "datastreams.*", "observe_datastream.b", "oid"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_ingest_token" "example" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_ingest_token.example", "datastreams.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,610 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceInvestigationNotebook() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("investigation_notebook", "description"),
+		CreateContext: resourceInvestigationNotebookCreate,
+		ReadContext:   resourceInvestigationNotebookRead,
+		UpdateContext: resourceInvestigationNotebookUpdate,
+		DeleteContext: resourceInvestigationNotebookDelete,
+		CustomizeDiff: resourceInvestigationNotebookCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				DiffSuppressFunc: diffSuppressWorkspace,
+				Deprecated:       "workspace is no longer required and will be ignored. It may be removed in a future version.",
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			"folder": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true, // Default folder when unset
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("common", "schema", "folder"),
+			},
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "name"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "description"),
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("common", "schema", "icon_url"),
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "timezone"),
+			},
+			"notes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions.Get("investigation_notebook", "schema", "notes"),
+			},
+			"runbook": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("investigation_notebook", "schema", "runbook", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "runbook", "url"),
+						},
+						"text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "runbook", "text"),
+						},
+					},
+				},
+			},
+			"trigger_context": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("investigation_notebook", "schema", "trigger_context", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"monitor": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateOID(oid.TypeMonitorV2),
+							DiffSuppressFunc: diffSuppressOIDVersion,
+							Description:      descriptions.Get("investigation_notebook", "schema", "trigger_context", "monitor"),
+						},
+						"source_url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: descriptions.Get("investigation_notebook", "schema", "trigger_context", "source_url"),
+						},
+					},
+				},
+			},
+			"block": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "block", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"markdown": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "markdown", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"text": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: descriptions.Get("investigation_notebook", "schema", "block", "markdown", "text"),
+									},
+								},
+							},
+						},
+						"image": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "image", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"url": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: descriptions.Get("investigation_notebook", "schema", "block", "image", "url"),
+									},
+									"description": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: descriptions.Get("investigation_notebook", "schema", "block", "content_description"),
+									},
+								},
+							},
+						},
+						"query": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: descriptions.Get("investigation_notebook", "schema", "block", "query", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"inputs": {
+										Type:             schema.TypeMap,
+										Required:         true,
+										ValidateDiagFunc: validateMapValues(validateOID()),
+										Description:      descriptions.Get("transform", "schema", "inputs"),
+									},
+									"stage": {
+										Type:        schema.TypeList,
+										MinItems:    1,
+										Required:    true,
+										Description: descriptions.Get("transform", "schema", "stage", "description"),
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"alias": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													DiffSuppressFunc: diffSuppressNotebookQueryStageAlias,
+													Description:      descriptions.Get("transform", "schema", "stage", "alias"),
+												},
+												"input": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: descriptions.Get("transform", "schema", "stage", "input"),
+												},
+												"pipeline": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: descriptions.Get("transform", "schema", "stage", "pipeline"),
+												},
+												"output_stage": {
+													Type:        schema.TypeBool,
+													Default:     false,
+													Optional:    true,
+													Description: descriptions.Get("transform", "schema", "stage", "output_stage"),
+												},
+											},
+										},
+									},
+									"description": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: descriptions.Get("investigation_notebook", "schema", "block", "content_description"),
+									},
+									"render_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: validateEnums(gql.AllNotebookQueryRenderTypes),
+										DiffSuppressFunc: diffSuppressEnums,
+										Description:      describeEnums(gql.AllNotebookQueryRenderTypes, descriptions.Get("investigation_notebook", "schema", "block", "query", "render_type")),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"summary": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("investigation_notebook", "schema", "summary"),
+			},
+		},
+	}
+}
+
+// diffSuppressNotebookQueryStageAlias ignores the alias of the last stage of
+// a query block, because it won't be set anyway.
+func diffSuppressNotebookQueryStageAlias(k, old, new string, d *schema.ResourceData) bool {
+	prefix := k[:strings.LastIndex(k, ".stage.")]
+	stage := d.Get(prefix + ".stage").([]interface{})
+	return k == fmt.Sprintf("%s.stage.%d.alias", prefix, len(stage)-1)
+}
+
+func resourceInvestigationNotebookCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("block") {
+		return nil
+	}
+
+	// values that are not yet known will be set by the time we apply
+	isSet := func(k string) bool {
+		_, ok := d.GetOk(k)
+		return ok || !d.NewValueKnown(k)
+	}
+
+	for i := range d.Get("block").([]interface{}) {
+		count := 0
+		for _, kind := range []string{"markdown", "image", "query"} {
+			if isSet(fmt.Sprintf("block.%d.%s", i, kind)) {
+				count++
+			}
+		}
+		if count != 1 {
+			return fmt.Errorf("block.%d: exactly one of markdown, image or query must be set", i)
+		}
+	}
+	return nil
+}
+
+func newNotebookBlockInput(d *schema.ResourceData, i int) (*gql.NotebookBlockInput, diag.Diagnostics) {
+	prefix := fmt.Sprintf("block.%d.", i)
+
+	if _, ok := d.GetOk(prefix + "markdown"); ok {
+		return &gql.NotebookBlockInput{
+			Type: gql.NotebookBlockTypeContentmarkdown,
+			Properties: gql.NotebookBlockPropertiesInput{
+				Markdown: &gql.NotebookMarkdownInput{
+					Text: d.Get(prefix + "markdown.0.text").(string),
+				},
+			},
+		}, nil
+	}
+
+	if _, ok := d.GetOk(prefix + "image"); ok {
+		return &gql.NotebookBlockInput{
+			Type: gql.NotebookBlockTypeContentimage,
+			Properties: gql.NotebookBlockPropertiesInput{
+				Image: &gql.NotebookImageInput{
+					Url:         stringPtr(d.Get(prefix + "image.0.url").(string)),
+					Description: d.Get(prefix + "image.0.description").(string),
+				},
+			},
+		}, nil
+	}
+
+	if _, ok := d.GetOk(prefix + "query"); ok {
+		query, diags := newQuery(prefixedResourceReader{d, prefix + "query.0."})
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		queryInput := &gql.NotebookQueryInput{
+			Query:       *query,
+			Description: d.Get(prefix + "query.0.description").(string),
+		}
+
+		if v, ok := d.GetOk(prefix + "query.0.render_type"); ok {
+			renderType := gql.NotebookQueryRenderType(toCamel(v.(string)))
+			queryInput.RenderType = &renderType
+		}
+
+		return &gql.NotebookBlockInput{
+			Type: gql.NotebookBlockTypeContentquery,
+			Properties: gql.NotebookBlockPropertiesInput{
+				Query: queryInput,
+			},
+		}, nil
+	}
+
+	return nil, diag.Errorf("block.%d: exactly one of markdown, image or query must be set", i)
+}
+
+func newInvestigationNotebookInput(d *schema.ResourceData) (*gql.InvestigationNotebookInput, diag.Diagnostics) {
+	input := &gql.InvestigationNotebookInput{
+		Name: d.Get("name").(string),
+		// context and runbook are always sent so that removing them from the
+		// configuration clears them.
+		Context: &gql.NotebookContextInput{
+			Context: make([]gql.NotebookContextValueInput, 0),
+			Notes:   make([]string, 0),
+		},
+		Runbook:        &gql.NotebookRunbookInfoInput{},
+		TriggerContext: &gql.InvestigationNotebookTriggerContextInput{},
+		Blocks:         make([]gql.NotebookBlockInput, 0),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("timezone"); ok {
+		input.Timezone = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("folder"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		input.FolderId = id.Version
+	}
+
+	for _, note := range d.Get("notes").([]interface{}) {
+		input.Context.Notes = append(input.Context.Notes, note.(string))
+	}
+
+	if v, ok := d.GetOk("runbook.0.url"); ok {
+		input.Runbook.Url = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("runbook.0.text"); ok {
+		input.Runbook.Text = stringPtr(v.(string))
+	}
+
+	if v, ok := d.GetOk("trigger_context.0.monitor"); ok {
+		id, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		input.TriggerContext.MonitorID = &id.Id
+	}
+
+	if v, ok := d.GetOk("trigger_context.0.source_url"); ok {
+		input.TriggerContext.SourceUrl = stringPtr(v.(string))
+	}
+
+	for i := range d.Get("block").([]interface{}) {
+		block, diags := newNotebookBlockInput(d, i)
+		if diags.HasError() {
+			return nil, diags
+		}
+		input.Blocks = append(input.Blocks, *block)
+	}
+
+	return input, nil
+}
+
+func resourceInvestigationNotebookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	wsid, err := client.ResolveWorkspaceID(ctx, maybeString(d.GetOk("workspace")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input, diags := newInvestigationNotebookInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	// notebooks managed by terraform should only contain the configured
+	// blocks, so don't let the initial investigation append any of its own.
+	skip := gql.InitialInvestigationModeSkip
+	input.InitialInvestigationMode = &skip
+
+	result, err := client.CreateInvestigationNotebook(ctx, wsid, input)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create investigation notebook",
+			Detail:   err.Error(),
+		})
+	}
+
+	d.SetId(result.Id)
+	return append(diags, resourceInvestigationNotebookRead(ctx, d, m)...)
+}
+
+func resourceInvestigationNotebookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	input, diags := newInvestigationNotebookInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	// blocks are append-only by default, replace them wholesale instead
+	input.ClearNotebookBlocks = boolPtr(true)
+
+	if _, err := client.UpdateInvestigationNotebook(ctx, d.Id(), input); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update investigation notebook",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourceInvestigationNotebookRead(ctx, d, m)...)
+}
+
+func resourceInvestigationNotebookRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	notebook, err := client.GetInvestigationNotebook(ctx, d.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read investigation notebook",
+			Detail:   err.Error(),
+		})
+	}
+
+	if err := d.Set("oid", notebook.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("name", notebook.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if notebook.Description != nil {
+		if err := d.Set("description", *notebook.Description); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if notebook.IconUrl != nil {
+		if err := d.Set("icon_url", *notebook.IconUrl); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if notebook.Timezone != nil {
+		if err := d.Set("timezone", *notebook.Timezone); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("workspace", oid.WorkspaceOid(notebook.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("folder", oid.FolderOid(notebook.FolderId, notebook.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var notes []string
+	if notebook.Context != nil {
+		notes = notebook.Context.Notes
+	}
+	if err := d.Set("notes", notes); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var runbook []interface{}
+	if r := notebook.Runbook; r != nil && (r.Url != nil || r.Text != nil) {
+		runbook = append(runbook, map[string]interface{}{
+			"url":  r.Url,
+			"text": r.Text,
+		})
+	}
+	if err := d.Set("runbook", runbook); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var triggerContext []interface{}
+	if t := notebook.TriggerContext; t != nil && (t.MonitorID != nil || t.SourceUrl != nil) {
+		trigger := map[string]interface{}{
+			"source_url": t.SourceUrl,
+		}
+		if t.MonitorID != nil {
+			trigger["monitor"] = oid.MonitorV2Oid(*t.MonitorID).String()
+		}
+		triggerContext = append(triggerContext, trigger)
+	}
+	if err := d.Set("trigger_context", triggerContext); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	blocks, err := flattenNotebookBlocks(d, notebook.Blocks)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	} else if err := d.Set("block", blocks); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("summary", notebook.Summary); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// flattenNotebookBlocks converts the current version of each block into
+// "block" attribute values. Blocks which are not markdown, image or query
+// blocks (e.g. added by an AI investigation) are not managed and are skipped.
+func flattenNotebookBlocks(d *schema.ResourceData, gqlBlocks []gql.InvestigationNotebookBlocksNotebookBlock) ([]interface{}, error) {
+	// blocks are append-only, so edits are recorded as a new block replacing
+	// its parent
+	replaced := make(map[string]bool)
+	for _, block := range gqlBlocks {
+		if block.Parent != nil && block.ParentRelation != nil && *block.ParentRelation == gql.NotebookBlockRelationReplace {
+			replaced[*block.Parent] = true
+		}
+	}
+
+	blocks := make([]interface{}, 0)
+	for _, block := range gqlBlocks {
+		if block.Id != nil && replaced[*block.Id] {
+			continue
+		}
+
+		props := block.Properties
+		switch {
+		case block.Type == gql.NotebookBlockTypeContentmarkdown && props.Markdown != nil:
+			blocks = append(blocks, map[string]interface{}{
+				"markdown": []interface{}{
+					map[string]interface{}{
+						"text": props.Markdown.Text,
+					},
+				},
+			})
+		case block.Type == gql.NotebookBlockTypeContentimage && props.Image != nil:
+			blocks = append(blocks, map[string]interface{}{
+				"image": []interface{}{
+					map[string]interface{}{
+						"url":         props.Image.Url,
+						"description": props.Image.Description,
+					},
+				},
+			})
+		case block.Type == gql.NotebookBlockTypeContentquery && props.Query != nil:
+			queryData, err := flattenQuery(props.Query.Query.Stages, props.Query.Query.OutputStage, false)
+			if err != nil {
+				return nil, err
+			}
+
+			prefix := fmt.Sprintf("block.%d.query.0.", len(blocks))
+			inputs, stages := flattenQueryAttributes(prefixedResourceReader{d, prefix}, queryData)
+
+			query := map[string]interface{}{
+				"inputs":      inputs,
+				"stage":       stages,
+				"description": props.Query.Description,
+			}
+			if props.Query.RenderType != nil {
+				query["render_type"] = toSnake(string(*props.Query.RenderType))
+			}
+
+			blocks = append(blocks, map[string]interface{}{
+				"query": []interface{}{query},
+			})
+		}
+	}
+	return blocks, nil
+}
+
+func resourceInvestigationNotebookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+	if err := client.DeleteInvestigationNotebook(ctx, d.Id()); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete investigation notebook",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,164 @@
+package observe
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveInvestigationNotebook(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_investigation_notebook" "runbook" {
+						name        = "%[1]s"
+						description = "steps to follow when ingest stalls"
+						notes       = ["collector restarts are expected during deploys"]
+
+						runbook {
+							url = "https://example.com/runbooks/ingest"
+						}
+
+						block {
+							markdown {
+								text = "# Check recent events"
+							}
+						}
+
+						block {
+							query {
+								inputs = {
+									"test" = observe_datastream.test.dataset
+								}
+								stage {
+									pipeline = "filter true"
+								}
+								description = "recent events"
+							}
+						}
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_investigation_notebook.runbook", "workspace"),
+					resource.TestCheckResourceAttrSet("observe_investigation_notebook.runbook", "folder"),
+					resource.TestCheckResourceAttrSet("observe_investigation_notebook.runbook", "oid"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "description", "steps to follow when ingest stalls"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "notes.#", "1"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "notes.0", "collector restarts are expected during deploys"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "runbook.0.url", "https://example.com/runbooks/ingest"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "block.#", "2"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "block.0.markdown.0.text", "# Check recent events"),
+					resource.TestCheckResourceAttrPair("observe_investigation_notebook.runbook", "block.1.query.0.inputs.test", "observe_datastream.test", "dataset"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "block.1.query.0.stage.0.pipeline", "filter true"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "block.1.query.0.description", "recent events"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "trigger_context.#", "0"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+					resource "observe_investigation_notebook" "runbook" {
+						name        = "%[1]s"
+						description = "steps to follow when ingest stalls"
+
+						block {
+							image {
+								url         = "https://example.com/architecture.png"
+								description = "ingest architecture"
+							}
+						}
+
+						block {
+							markdown {
+								text = "# Check recent events"
+							}
+						}
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "notes.#", "0"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "runbook.#", "0"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "block.#", "2"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "block.0.image.0.url", "https://example.com/architecture.png"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "block.0.image.0.description", "ingest architecture"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "block.1.markdown.0.text", "# Check recent events"),
+				),
+			},
+			{
+				ResourceName:      "observe_investigation_notebook.runbook",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccObserveInvestigationNotebookTriggerContext(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(monitorV2MuteRuleConfigPreamble+`
+					resource "observe_investigation_notebook" "runbook" {
+						name = "%[1]s"
+
+						trigger_context {
+							monitor = observe_monitor_v2.first.oid
+						}
+
+						block {
+							markdown {
+								text = "Check the [ingest dashboard](https://example.com/ingest)."
+							}
+						}
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "name", randomPrefix),
+					resource.TestCheckResourceAttrPair("observe_investigation_notebook.runbook", "trigger_context.0.monitor", "observe_monitor_v2.first", "oid"),
+					resource.TestCheckResourceAttr("observe_investigation_notebook.runbook", "block.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObserveInvestigationNotebookInvalidBlock(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+					resource "observe_investigation_notebook" "runbook" {
+						name = "%[1]s"
+
+						block {
+							markdown {
+								text = "# Overview"
+							}
+							image {
+								url = "https://example.com/architecture.png"
+							}
+						}
+					}
+				`, randomPrefix),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`block.0: exactly one of markdown, image or query must be set`),
+			},
+		},
+	})
+}
@@ -0,0 +1,173 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceKubernetesContent() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("kubernetes_content", "description"),
+		CreateContext: resourceKubernetesContentCreate,
+		ReadContext:   resourceKubernetesContentRead,
+		UpdateContext: resourceKubernetesContentUpdate,
+		DeleteContext: resourceKubernetesContentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"otel_logs_dataset":  contentSourceDatasetSchema(descriptions.Get("kubernetes_content", "schema", "otel_logs_dataset"), false),
+			"prometheus_dataset": contentSourceDatasetSchema(descriptions.Get("kubernetes_content", "schema", "prometheus_dataset"), false),
+			"entity_dataset":     contentSourceDatasetSchema(descriptions.Get("kubernetes_content", "schema", "entity_dataset"), false),
+			"rematerialization_action": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          toSnake(string(gql.RematerializationActionAbortonrematerialization)),
+				ValidateDiagFunc: validateEnums(gql.AllRematerializationActions),
+				Description:      describeEnums(gql.AllRematerializationActions, descriptions.Get("kubernetes_content", "schema", "rematerialization_action")),
+			},
+			"kubernetes_logs_dataset": contentDatasetSchema(descriptions.Get("kubernetes_content", "schema", "kubernetes_logs_dataset")),
+		},
+	}
+}
+
+// contentSourceDatasetSchema is shared by all content resources for the
+// datasets the content is built on. If not set, the content picks a default.
+func contentSourceDatasetSchema(description string, forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ForceNew:         forceNew,
+		ValidateDiagFunc: validateOID(oid.TypeDataset),
+		DiffSuppressFunc: diffSuppressOIDVersion,
+		Description:      description,
+	}
+}
+
+// contentDatasetSchema is shared by all content resources for the datasets
+// created by the content.
+func contentDatasetSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: description,
+	}
+}
+
+// contentDatasetId returns the id of the dataset configured for key, or nil
+// if it is not set.
+func contentDatasetId(d *schema.ResourceData, key string) (*string, error) {
+	v, ok := d.GetOk(key)
+	if !ok {
+		return nil, nil
+	}
+	id, err := oid.NewOID(v.(string))
+	if err != nil {
+		return nil, err
+	}
+	return &id.Id, nil
+}
+
+// setContentDatasets sets the dataset OIDs returned by a content query. Source
+// datasets are only updated if they changed, to avoid a diff on the dataset
+// version.
+func setContentDatasets(d *schema.ResourceData, datasets map[string]*string) (diags diag.Diagnostics) {
+	for key, id := range datasets {
+		var value string
+		if id != nil && *id != "" {
+			value = oid.DatasetOid(*id).String()
+		}
+		if current, err := oid.NewOID(d.Get(key).(string)); err == nil && value != "" && current.Id == *id {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	return diags
+}
+
+func newKubernetesContentInput(d *schema.ResourceData) (input *gql.KubernetesContentInput, diags diag.Diagnostics) {
+	input = &gql.KubernetesContentInput{}
+	var err error
+	if input.OtelLogsDatasetId, err = contentDatasetId(d, "otel_logs_dataset"); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if input.PrometheusDatasetId, err = contentDatasetId(d, "prometheus_dataset"); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if input.EntityDatasetId, err = contentDatasetId(d, "entity_dataset"); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	return input, nil
+}
+
+func resourceKubernetesContentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	// we just set a constant id since there's only one of this content per tenant
+	d.SetId("kubernetes_content")
+	return resourceKubernetesContentUpdate(ctx, d, m)
+}
+
+func resourceKubernetesContentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	input, diags := newKubernetesContentInput(d)
+	if diags.HasError() {
+		return diags
+	}
+
+	action := gql.RematerializationAction(toCamel(d.Get("rematerialization_action").(string)))
+	if _, err := client.UpdateKubernetesContent(ctx, input, &action); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update kubernetes content",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourceKubernetesContentRead(ctx, d, m)...)
+}
+
+func resourceKubernetesContentRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	content, err := client.GetKubernetesContent(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read kubernetes content",
+			Detail:   err.Error(),
+		})
+	}
+
+	if content == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setContentDatasets(d, map[string]*string{
+		"otel_logs_dataset":       content.OtelLogsDatasetId,
+		"prometheus_dataset":      content.PrometheusDatasetId,
+		"entity_dataset":          content.EntityDatasetId,
+		"kubernetes_logs_dataset": content.KubernetesLogsDatasetId,
+	})
+}
+
+func resourceKubernetesContentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+	if err := client.DeleteKubernetesContent(ctx); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete kubernetes content",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
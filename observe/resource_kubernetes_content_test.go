@@ -0,0 +1,35 @@
+package observe
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveKubernetesContent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configPreamble + `
+				resource "observe_kubernetes_content" "default" {
+					rematerialization_action = "ignore_rematerialization"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_kubernetes_content.default", "id", "kubernetes_content"),
+					resource.TestCheckResourceAttrSet("observe_kubernetes_content.default", "otel_logs_dataset"),
+					resource.TestCheckResourceAttrSet("observe_kubernetes_content.default", "kubernetes_logs_dataset"),
+				),
+			},
+			{
+				ResourceName:            "observe_kubernetes_content.default",
+				ImportState:             true,
+				ImportStateId:           "kubernetes_content",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rematerialization_action"},
+			},
+		},
+	})
+}
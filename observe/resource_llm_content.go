@@ -0,0 +1,96 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceLlmContent() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("llm_content", "description"),
+		CreateContext: resourceLlmContentCreate,
+		ReadContext:   resourceLlmContentRead,
+		DeleteContext: resourceLlmContentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"span_raw_dataset":     tracingSourceDatasetSchema(descriptions.Get("llm_content", "schema", "span_raw_dataset")),
+			"span_event_dataset":   tracingSourceDatasetSchema(descriptions.Get("llm_content", "schema", "span_event_dataset")),
+			"span_link_dataset":    tracingSourceDatasetSchema(descriptions.Get("llm_content", "schema", "span_link_dataset")),
+			"otel_metrics_dataset": tracingSourceDatasetSchema(descriptions.Get("llm_content", "schema", "otel_metrics_dataset")),
+
+			"llm_spans_dataset":   contentDatasetSchema(descriptions.Get("llm_content", "schema", "llm_spans_dataset")),
+			"llm_metrics_dataset": contentDatasetSchema(descriptions.Get("llm_content", "schema", "llm_metrics_dataset")),
+		},
+	}
+}
+
+func resourceLlmContentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	ids, err := tracingSourceDatasetIds(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var input *gql.LlmContentInput
+	if ids != nil {
+		input = &gql.LlmContentInput{
+			SpanRawDatasetId:     ids[0],
+			SpanEventDatasetId:   ids[1],
+			SpanLinkDatasetId:    ids[2],
+			OtelMetricsDatasetId: ids[3],
+		}
+	}
+
+	if _, err := client.InstallLlmContent(ctx, input); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to install llm content",
+			Detail:   err.Error(),
+		})
+	}
+
+	// we just set a constant id since there's only one of this content per tenant
+	d.SetId("llm_content")
+	return append(diags, resourceLlmContentRead(ctx, d, m)...)
+}
+
+func resourceLlmContentRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	content, err := client.GetLlmContent(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read llm content",
+			Detail:   err.Error(),
+		})
+	}
+
+	if content == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setContentDatasets(d, map[string]*string{
+		"span_raw_dataset":     &content.SpanRawDatasetId,
+		"span_event_dataset":   &content.SpanEventDatasetId,
+		"span_link_dataset":    &content.SpanLinkDatasetId,
+		"otel_metrics_dataset": &content.OtelMetricsDatasetId,
+		"llm_spans_dataset":    &content.LlmSpansDatasetId,
+		"llm_metrics_dataset":  &content.LlmMetricsDatasetId,
+	})
+}
+
+func resourceLlmContentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	// the API does not support uninstalling llm content, so we only remove it
+	// from state
+	return diags
+}
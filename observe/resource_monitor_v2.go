@@ -66,6 +66,14 @@ func resourceMonitorV2() *schema.Resource {
 				Default:     false,
 				Description: descriptions.Get("monitorv2", "schema", "disabled"),
 			},
+			"ai_triaging_mode": { // MonitorV2AiTriagingMode
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateEnums(gql.AllMonitorV2AiTriagingModes),
+				DiffSuppressFunc: diffSuppressEnums,
+				Description:      describeEnums(gql.AllMonitorV2AiTriagingModes, descriptions.Get("monitorv2", "schema", "ai_triaging_mode")),
+			},
 			// until specified otherwise, the following are for building MonitorV2DefinitionInput
 			"stage": { // for building inputQuery (MultiStageQueryInput!))
 				Type:        schema.TypeList,
@@ -963,6 +971,12 @@ func monitorV2ToResourceData(ctx context.Context, monitor *gql.MonitorV2, data *
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
+	if monitor.AiTriagingMode != nil {
+		if err := data.Set("ai_triaging_mode", toSnake(string(*monitor.AiTriagingMode))); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
 	_, err := flattenAndSetQuery(data, monitor.Definition.InputQuery.Stages, monitor.Definition.InputQuery.OutputStage, dedentPipelines)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
@@ -1491,6 +1505,10 @@ func newMonitorV2Input(data *schema.ResourceData) (input *gql.MonitorV2Input, di
 		input.Description = stringPtr(v.(string))
 	}
 	input.Disabled = boolPtr(data.Get("disabled").(bool))
+	if v, ok := data.GetOk("ai_triaging_mode"); ok {
+		mode := gql.MonitorV2AiTriagingMode(toCamel(v.(string)))
+		input.AiTriagingMode = &mode
+	}
 
 	return input, diags
 }
@@ -11,6 +11,7 @@ import (
 	gql "github.com/observeinc/terraform-provider-observe/client/meta"
 	"github.com/observeinc/terraform-provider-observe/client/meta/types"
 	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
 )
 
 func resourceMonitorV2Action() *schema.Resource {
@@ -38,6 +39,7 @@ func resourceMonitorV2Action() *schema.Resource {
 				ValidateDiagFunc: validateEnums(gql.AllMonitorV2ActionTypes),
 				DiffSuppressFunc: diffSuppressEnums,
 				Required:         true,
+				Description:      descriptions.Get("monitor_v2_action", "schema", "type"),
 			},
 			"email": { // MonitorV2EmailDestinationInput
 				Type:         schema.TypeList,
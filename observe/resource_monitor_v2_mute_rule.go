@@ -0,0 +1,448 @@
+package observe
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceMonitorV2MuteRule() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("monitor_v2_mute_rule", "description"),
+		CreateContext: resourceMonitorV2MuteRuleCreate,
+		ReadContext:   resourceMonitorV2MuteRuleRead,
+		UpdateContext: resourceMonitorV2MuteRuleUpdate,
+		DeleteContext: resourceMonitorV2MuteRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			// needed as input to CreateMonitorV2MuteRule
+			"workspace": { // ObjectId!
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateOID(oid.TypeWorkspace),
+				DiffSuppressFunc: diffSuppressWorkspace,
+				Deprecated:       "workspace is no longer required and will be ignored. It may be removed in a future version.",
+				Description:      descriptions.Get("common", "schema", "workspace"),
+			},
+			// fields of MonitorV2MuteRuleInput
+			"name": { // String!
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "name"),
+			},
+			"description": { // String
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "description"),
+			},
+			"icon_url": { // String
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "icon_url"),
+			},
+			"folder": { // ObjectId
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true, // Default folder when unset
+				ValidateDiagFunc: validateOID(oid.TypeFolder),
+				Description:      descriptions.Get("monitor_v2_mute_rule", "schema", "folder"),
+			},
+			"monitor": { // ObjectId
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOID(oid.TypeMonitorV2),
+				Description:      descriptions.Get("monitor_v2_mute_rule", "schema", "monitor"),
+			},
+			"schedule": { // MonitorV2MuteRuleScheduleInput!
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"one_time": { // MonitorV2OneTimeMuteScheduleInput
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"schedule.0.one_time", "schedule.0.recurring"},
+							Description:  descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "one_time", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_time": { // Time!
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validateTimestamp,
+										DiffSuppressFunc: diffSuppressTimestamp,
+										Description:      descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "one_time", "start_time"),
+									},
+									"end_time": { // Time
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: validateTimestamp,
+										DiffSuppressFunc: diffSuppressTimestamp,
+										Description:      descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "one_time", "end_time"),
+									},
+								},
+							},
+						},
+						"recurring": { // MonitorV2MuteCronScheduleInput
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"schedule.0.one_time", "schedule.0.recurring"},
+							Description:  descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "recurring", "description"),
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"raw_cron": { // String
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "recurring", "raw_cron"),
+									},
+									"timezone": { // String!
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validateStringIsTimezone,
+										Description:      descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "recurring", "timezone"),
+									},
+									"duration": { // Duration!
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validateTimeDuration,
+										DiffSuppressFunc: diffSuppressTimeDuration,
+										Description:      descriptions.Get("monitor_v2_mute_rule", "schema", "schedule", "recurring", "duration"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"criteria": { // MonitorV2ComparisonExpressionInput
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "criteria", "description"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// as with monitor action conditions, sub_expressions are not yet supported.
+						"compare_terms": { // [MonitorV2ComparisonTerm!]
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparison": { // [MonitorV2Comparison!]!
+										Type:        schema.TypeList,
+										Required:    true,
+										MinItems:    1,
+										Elem:        monitorV2ComparisonResource(),
+										Description: descriptions.Get("monitor_v2_mute_rule", "schema", "criteria", "compare_terms", "comparison"),
+									},
+									"column": { // [MonitorV2Column!]!
+										Type:        schema.TypeList,
+										Required:    true,
+										MinItems:    1,
+										Elem:        monitorV2ColumnResource(),
+										Description: descriptions.Get("monitor_v2_mute_rule", "schema", "criteria", "compare_terms", "column"),
+									},
+								},
+							},
+						},
+						"operator": { // MonitorV2BooleanOperator!
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          toSnake(string(gql.MonitorV2BooleanOperatorAnd)),
+							ValidateDiagFunc: validateEnums(gql.AllMonitorV2BooleanOperators),
+							Description:      descriptions.Get("monitor_v2_mute_rule", "schema", "criteria", "operator"),
+						},
+					},
+				},
+			},
+			// end of MonitorV2MuteRuleInput
+			"oid": { // ObjectId!
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"valid_from": { // Time!
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "valid_from"),
+			},
+			"valid_to": { // Time
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "valid_to"),
+			},
+			"is_global": { // Boolean!
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "is_global"),
+			},
+			"is_conditional": { // Boolean!
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("monitor_v2_mute_rule", "schema", "is_conditional"),
+			},
+		},
+	}
+}
+
+func resourceMonitorV2MuteRuleCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newMonitorV2MuteRuleInput(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	wsid, err := client.ResolveWorkspaceID(ctx, maybeString(data.GetOk("workspace")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	result, err := client.CreateMonitorV2MuteRule(ctx, wsid, input)
+	if err != nil {
+		return diag.Errorf("failed to create monitor mute rule: %s", err.Error())
+	}
+
+	data.SetId(result.Id)
+	return append(diags, resourceMonitorV2MuteRuleRead(ctx, data, meta)...)
+}
+
+func resourceMonitorV2MuteRuleUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	input, diags := newMonitorV2MuteRuleInput(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := client.UpdateMonitorV2MuteRule(ctx, data.Id(), input)
+	if err != nil {
+		if gql.HasErrorCode(err, "NOT_FOUND") {
+			diags = resourceMonitorV2MuteRuleCreate(ctx, data, meta)
+			if diags.HasError() {
+				return diags
+			}
+			return nil
+		}
+		return diag.Errorf("failed to update monitor mute rule: %s", err.Error())
+	}
+
+	return append(diags, resourceMonitorV2MuteRuleRead(ctx, data, meta)...)
+}
+
+func resourceMonitorV2MuteRuleDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if err := client.DeleteMonitorV2MuteRule(ctx, data.Id()); err != nil {
+		return diag.Errorf("failed to delete monitor mute rule: %s", err.Error())
+	}
+	return diags
+}
+
+func resourceMonitorV2MuteRuleRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	muteRule, err := client.GetMonitorV2MuteRule(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, "NOT_FOUND") {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read monitor mute rule: %s", err.Error())
+	}
+
+	if err := data.Set("workspace", oid.WorkspaceOid(muteRule.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("folder", oid.FolderOid(muteRule.FolderId, muteRule.WorkspaceId).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("name", muteRule.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if muteRule.Description != nil {
+		if err := data.Set("description", *muteRule.Description); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if muteRule.IconUrl != nil {
+		if err := data.Set("icon_url", *muteRule.IconUrl); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	var monitor string
+	if muteRule.MonitorID != nil {
+		monitor = oid.MonitorV2Oid(*muteRule.MonitorID).String()
+	}
+	if err := data.Set("monitor", monitor); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("schedule", monitorV2FlattenMuteRuleSchedule(muteRule.Schedule)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var criteria []any
+	if muteRule.Criteria != nil && len(muteRule.Criteria.CompareTerms) != 0 {
+		criteria = []any{monitorV2FlattenComparisonExpression(muteRule.Criteria)}
+	}
+	if err := data.Set("criteria", criteria); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("valid_from", muteRule.ValidFrom.String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var validTo string
+	if muteRule.ValidTo != nil {
+		validTo = muteRule.ValidTo.String()
+	}
+	if err := data.Set("valid_to", validTo); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("is_global", muteRule.IsGlobal); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("is_conditional", muteRule.IsConditional); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oid", muteRule.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func monitorV2FlattenMuteRuleSchedule(gqlSchedule gql.MonitorV2MuteRuleSchedule) []any {
+	schedule := make(map[string]any)
+	if gqlSchedule.OneTime != nil {
+		oneTime := map[string]any{
+			"start_time": gqlSchedule.OneTime.StartTime.String(),
+		}
+		if gqlSchedule.OneTime.EndTime != nil {
+			oneTime["end_time"] = gqlSchedule.OneTime.EndTime.String()
+		}
+		schedule["one_time"] = []any{oneTime}
+	}
+	if gqlSchedule.Recurring != nil {
+		recurring := map[string]any{
+			"timezone": gqlSchedule.Recurring.CronSchedule.Timezone,
+			"duration": gqlSchedule.Recurring.Duration.String(),
+		}
+		if gqlSchedule.Recurring.CronSchedule.RawCron != nil {
+			recurring["raw_cron"] = *gqlSchedule.Recurring.CronSchedule.RawCron
+		}
+		schedule["recurring"] = []any{recurring}
+	}
+	return []any{schedule}
+}
+
+func newMonitorV2MuteRuleInput(data *schema.ResourceData) (input *gql.MonitorV2MuteRuleInput, diags diag.Diagnostics) {
+	// required
+	schedule, diags := newMonitorV2MuteRuleScheduleInput("schedule.0.", data)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// instantiation
+	input = &gql.MonitorV2MuteRuleInput{
+		Name:     data.Get("name").(string),
+		Schedule: *schedule,
+	}
+
+	// optionals
+	if v, ok := data.GetOk("description"); ok {
+		input.Description = stringPtr(v.(string))
+	}
+	if v, ok := data.GetOk("icon_url"); ok {
+		input.IconUrl = stringPtr(v.(string))
+	}
+	if v, ok := data.GetOk("folder"); ok {
+		folderOid, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		input.FolderId = folderOid.Version
+	}
+	if v, ok := data.GetOk("monitor"); ok {
+		monitorOid, err := oid.NewOID(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		input.MonitorID = &monitorOid.Id
+	}
+	if _, ok := data.GetOk("criteria"); ok {
+		criteria, diags := newMonitorV2ComparisonExpressionInput("criteria.0.", data)
+		if diags.HasError() {
+			return nil, diags
+		}
+		input.Criteria = criteria
+	}
+
+	return input, diags
+}
+
+func newMonitorV2MuteRuleScheduleInput(path string, data *schema.ResourceData) (schedule *gql.MonitorV2MuteRuleScheduleInput, diags diag.Diagnostics) {
+	schedule = &gql.MonitorV2MuteRuleScheduleInput{}
+
+	// the schedule type is implied by whichever of one_time or recurring is set
+	if _, ok := data.GetOk(fmt.Sprintf("%sone_time", path)); ok {
+		schedule.Type = gql.MonitorV2MuteScheduleTypeOnetime
+		oneTime := &gql.MonitorV2OneTimeMuteScheduleInput{}
+
+		startTime, err := time.Parse(time.RFC3339, data.Get(fmt.Sprintf("%sone_time.0.start_time", path)).(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		oneTime.StartTime = types.TimeScalar(startTime)
+
+		if v, ok := data.GetOk(fmt.Sprintf("%sone_time.0.end_time", path)); ok {
+			endTime, err := time.Parse(time.RFC3339, v.(string))
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			endTimeScalar := types.TimeScalar(endTime)
+			oneTime.EndTime = &endTimeScalar
+		}
+		schedule.OneTime = oneTime
+	}
+
+	if _, ok := data.GetOk(fmt.Sprintf("%srecurring", path)); ok {
+		schedule.Type = gql.MonitorV2MuteScheduleTypeRecurring
+		duration, err := types.ParseDurationScalar(data.Get(fmt.Sprintf("%srecurring.0.duration", path)).(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		rawCron := data.Get(fmt.Sprintf("%srecurring.0.raw_cron", path)).(string)
+		schedule.Recurring = &gql.MonitorV2MuteCronScheduleInput{
+			CronSchedule: gql.MonitorV2CronScheduleInput{
+				RawCron:  &rawCron,
+				Timezone: data.Get(fmt.Sprintf("%srecurring.0.timezone", path)).(string),
+			},
+			Duration: *duration,
+		}
+	}
+
+	return schedule, diags
+}
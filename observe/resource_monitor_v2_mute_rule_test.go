@@ -0,0 +1,185 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var monitorV2MuteRuleConfigPreamble = monitorV2ConfigPreamble + `
+	resource "observe_monitor_v2" "first" {
+		workspace = data.observe_workspace.default.oid
+		rule_kind = "count"
+		name = "%[1]s"
+		lookback_time = "30m"
+		inputs = {
+			"test" = observe_datastream.test.dataset
+		}
+		stage {
+			pipeline = <<-EOF
+				colmake kind:"test", description:"test"
+			EOF
+			output_stage = true
+		}
+		rules {
+			level = "informational"
+			count {
+				compare_values {
+					compare_fn = "greater"
+					value_int64 = [0]
+				}
+			}
+		}
+		scheduling {
+			transform {
+				freshness_goal = "15m"
+			}
+		}
+	}
+`
+
+func TestAccObserveMonitorV2MuteRuleOneTime(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(monitorV2MuteRuleConfigPreamble+`
+					resource "observe_monitor_v2_mute_rule" "mute" {
+						name = "%[1]s"
+						description = "scheduled deploy"
+						monitor = observe_monitor_v2.first.oid
+						schedule {
+							one_time {
+								start_time = "2030-01-01T00:00:00Z"
+								end_time = "2030-01-01T02:00:00Z"
+							}
+						}
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("observe_monitor_v2_mute_rule.mute", "workspace"),
+					resource.TestCheckResourceAttrSet("observe_monitor_v2_mute_rule.mute", "folder"),
+					resource.TestCheckResourceAttrSet("observe_monitor_v2_mute_rule.mute", "oid"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "description", "scheduled deploy"),
+					resource.TestCheckResourceAttrPair("observe_monitor_v2_mute_rule.mute", "monitor", "observe_monitor_v2.first", "oid"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "schedule.0.one_time.0.start_time", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "schedule.0.one_time.0.end_time", "2030-01-01T02:00:00Z"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "schedule.0.recurring.#", "0"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "criteria.#", "0"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "is_global", "false"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "is_conditional", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(monitorV2MuteRuleConfigPreamble+`
+					resource "observe_monitor_v2_mute_rule" "mute" {
+						name = "%[1]s"
+						description = "extended deploy"
+						monitor = observe_monitor_v2.first.oid
+						schedule {
+							one_time {
+								start_time = "2030-01-01T00:00:00Z"
+							}
+						}
+						criteria {
+							compare_terms {
+								comparison {
+									compare_fn = "equal"
+									value_string = ["test"]
+								}
+								column {
+									column_path {
+										name = "kind"
+									}
+								}
+							}
+						}
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "description", "extended deploy"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "schedule.0.one_time.0.end_time", ""),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "criteria.0.operator", "and"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "criteria.0.compare_terms.0.comparison.0.compare_fn", "equal"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "criteria.0.compare_terms.0.comparison.0.value_string.0", "test"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "criteria.0.compare_terms.0.column.0.column_path.0.name", "kind"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "is_conditional", "true"),
+				),
+			},
+			{
+				ResourceName:      "observe_monitor_v2_mute_rule.mute",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccObserveMonitorV2MuteRuleRecurringGlobal(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(monitorV2MuteRuleConfigPreamble+`
+					resource "observe_monitor_v2_mute_rule" "mute" {
+						name = "%[1]s"
+						schedule {
+							recurring {
+								raw_cron = "0 2 * * 6"
+								timezone = "America/Los_Angeles"
+								duration = "2h"
+							}
+						}
+						criteria {
+							operator = "or"
+							compare_terms {
+								comparison {
+									compare_fn = "equal"
+									value_string = ["deploy"]
+								}
+								column {
+									column_path {
+										name = "kind"
+									}
+								}
+							}
+							compare_terms {
+								comparison {
+									compare_fn = "equal"
+									value_string = ["maintenance"]
+								}
+								column {
+									column_path {
+										name = "description"
+									}
+								}
+							}
+						}
+					}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "name", randomPrefix),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "monitor", ""),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "schedule.0.one_time.#", "0"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "schedule.0.recurring.0.raw_cron", "0 2 * * 6"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "schedule.0.recurring.0.timezone", "America/Los_Angeles"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "schedule.0.recurring.0.duration", "2h0m0s"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "criteria.0.operator", "or"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "criteria.0.compare_terms.#", "2"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "is_global", "true"),
+					resource.TestCheckResourceAttr("observe_monitor_v2_mute_rule.mute", "is_conditional", "true"),
+					resource.TestCheckResourceAttrSet("observe_monitor_v2_mute_rule.mute", "valid_from"),
+				),
+			},
+		},
+	})
+}
@@ -28,6 +28,7 @@ func TestAccObserveMonitorV2Count(t *testing.T) {
 						rule_kind = "count"
 						name = "%[1]s"
 						lookback_time = "30m"
+						ai_triaging_mode = "triage"
 						inputs = {
 							"test" = observe_datastream.test.dataset
 						}
@@ -63,6 +64,7 @@ func TestAccObserveMonitorV2Count(t *testing.T) {
 					resource.TestCheckResourceAttr("observe_monitor_v2.first", "name", randomPrefix),
 					resource.TestCheckResourceAttr("observe_monitor_v2.first", "lookback_time", "30m0s"),
 					resource.TestCheckResourceAttr("observe_monitor_v2.first", "rule_kind", "count"),
+					resource.TestCheckResourceAttr("observe_monitor_v2.first", "ai_triaging_mode", "triage"),
 					resource.TestCheckResourceAttr("observe_monitor_v2.first", "rules.0.level", "informational"),
 					resource.TestCheckResourceAttr("observe_monitor_v2.first", "rules.0.count.0.compare_values.0.compare_fn", "greater"),
 					resource.TestCheckResourceAttr("observe_monitor_v2.first", "rules.0.count.0.compare_values.0.value_int64.0", "0"),
@@ -0,0 +1,94 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceMonitoringContent() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("monitoring_content", "description"),
+		CreateContext: resourceMonitoringContentCreate,
+		ReadContext:   resourceMonitoringContentRead,
+		DeleteContext: resourceMonitoringContentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"system_dataset":     contentSourceDatasetSchema(descriptions.Get("monitoring_content", "schema", "system_dataset"), true),
+			"detections_dataset": contentDatasetSchema(descriptions.Get("monitoring_content", "schema", "detections_dataset")),
+			"alarms_dataset":     contentDatasetSchema(descriptions.Get("monitoring_content", "schema", "alarms_dataset")),
+			"messages_dataset":   contentDatasetSchema(descriptions.Get("monitoring_content", "schema", "messages_dataset")),
+			"stats_dataset":      contentDatasetSchema(descriptions.Get("monitoring_content", "schema", "stats_dataset")),
+		},
+	}
+}
+
+func resourceMonitoringContentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	id, err := contentDatasetId(d, "system_dataset")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var input *gql.MonitoringContentInput
+	if id != nil {
+		input = &gql.MonitoringContentInput{SystemDatasetId: *id}
+	}
+
+	if _, err := client.InstallMonitoringContent(ctx, input); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to install monitoring content",
+			Detail:   err.Error(),
+		})
+	}
+
+	// we just set a constant id since there's only one of this content per tenant
+	d.SetId("monitoring_content")
+	return append(diags, resourceMonitoringContentRead(ctx, d, m)...)
+}
+
+func resourceMonitoringContentRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	content, err := client.GetMonitoringContent(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read monitoring content",
+			Detail:   err.Error(),
+		})
+	}
+
+	if content == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setContentDatasets(d, map[string]*string{
+		"system_dataset":     &content.SystemDatasetId,
+		"detections_dataset": &content.DetectionsDatasetId,
+		"alarms_dataset":     &content.AlarmsDatasetId,
+		"messages_dataset":   &content.MessagesDatasetId,
+		"stats_dataset":      &content.StatsDatasetId,
+	})
+}
+
+func resourceMonitoringContentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+	if err := client.DeleteMonitoringContent(ctx); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete monitoring content",
+			Detail:   err.Error(),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,33 @@
+package observe
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveMonitoringContent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configPreamble + `
+				resource "observe_monitoring_content" "default" {}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_monitoring_content.default", "id", "monitoring_content"),
+					resource.TestCheckResourceAttrSet("observe_monitoring_content.default", "system_dataset"),
+					resource.TestCheckResourceAttrSet("observe_monitoring_content.default", "detections_dataset"),
+					resource.TestCheckResourceAttrSet("observe_monitoring_content.default", "alarms_dataset"),
+				),
+			},
+			{
+				ResourceName:      "observe_monitoring_content.default",
+				ImportState:       true,
+				ImportStateId:     "monitoring_content",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,117 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceObjectOwner() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("object_owner", "description"),
+		CreateContext: resourceObjectOwnerCreate,
+		ReadContext:   resourceObjectOwnerRead,
+		UpdateContext: resourceObjectOwnerUpdate,
+		DeleteContext: resourceObjectOwnerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"object": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(gql.OwnableObjectTypes...),
+				DiffSuppressFunc: diffSuppressOIDVersion,
+				Description:      descriptions.Get("object_owner", "schema", "object"),
+			},
+			"owner": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOID(oid.TypeUser),
+				Description:      descriptions.Get("object_owner", "schema", "owner"),
+			},
+		},
+	}
+}
+
+func resourceObjectOwnerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	object, err := oid.NewOID(d.Get("object").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the id is the unversioned object OID, since reading the owner depends
+	// on the object type
+	d.SetId(oid.OID{Type: object.Type, Id: object.Id}.String())
+	return resourceObjectOwnerUpdate(ctx, d, m)
+}
+
+func resourceObjectOwnerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	object, err := oid.NewOID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	owner, err := oid.NewOID(d.Get("owner").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.SetWorkspaceObjectOwner(ctx, object.Id, *oid.OidToUserId(*owner)); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set object owner",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourceObjectOwnerRead(ctx, d, m)...)
+}
+
+func resourceObjectOwnerRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	object, err := oid.NewOID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	owner, err := client.GetWorkspaceObjectOwner(ctx, *object)
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read object owner",
+			Detail:   err.Error(),
+		})
+	}
+
+	if _, ok := d.GetOk("object"); !ok {
+		// only set on import, to avoid a diff on the dataset version
+		if err := d.Set("object", object.String()); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if err := d.Set("owner", oid.UserOid(owner).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceObjectOwnerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	// objects always have an owner, so it is only removed from state
+	return diags
+}
@@ -0,0 +1,52 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveObjectOwner(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+datastreamConfigPreamble+`
+				data "observe_user" "system" {
+					email = "%[2]s"
+				}
+
+				resource "observe_object_owner" "dataset" {
+					object = observe_datastream.test.dataset
+					owner  = data.observe_user.system.oid
+				}
+
+				resource "observe_folder" "example" {
+					workspace = data.observe_workspace.default.oid
+					name      = "%[1]s"
+				}
+
+				resource "observe_object_owner" "folder" {
+					object = observe_folder.example.oid
+					owner  = data.observe_user.system.oid
+				}
+				`, randomPrefix, systemUser()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("observe_object_owner.dataset", "owner", "data.observe_user.system", "oid"),
+					resource.TestCheckResourceAttrPair("observe_object_owner.folder", "owner", "data.observe_user.system", "oid"),
+				),
+			},
+			{
+				ResourceName:            "observe_object_owner.dataset",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"object"},
+			},
+		},
+	})
+}
@@ -0,0 +1,190 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourcePasswordPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("password_policy", "description"),
+		CreateContext: resourcePasswordPolicyCreate,
+		ReadContext:   resourcePasswordPolicyRead,
+		UpdateContext: resourcePasswordPolicyUpdate,
+		DeleteContext: resourcePasswordPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"min_length": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      descriptions.Get("password_policy", "schema", "min_length"),
+			},
+			"require_special": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("password_policy", "schema", "require_special"),
+			},
+			"expiration_days": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      descriptions.Get("password_policy", "schema", "expiration_days"),
+			},
+			"force_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("password_policy", "schema", "force_destroy"),
+			},
+			// computed values
+			"min_distinct_chars": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: descriptions.Get("password_policy", "schema", "min_distinct_chars"),
+			},
+			"require_uppercase": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("password_policy", "schema", "require_uppercase"),
+			},
+			"require_lowercase": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("password_policy", "schema", "require_lowercase"),
+			},
+			"require_number": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("password_policy", "schema", "require_number"),
+			},
+			"enforce_complexity_for_existing": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: descriptions.Get("password_policy", "schema", "enforce_complexity_for_existing"),
+			},
+		},
+	}
+}
+
+func newPasswordPolicyInput(d *schema.ResourceData) *gql.PasswordPolicyInput {
+	input := &gql.PasswordPolicyInput{
+		Complexity: &gql.PasswordPolicyComplexityInput{},
+	}
+
+	// GetOk returns !ok for the zero value of the type, so GetOkExists is
+	// needed to send e.g. require_special = false or expiration_days = 0
+	if v, ok := d.GetOkExists("min_length"); ok {
+		input.Complexity.MinLength = intPtr(v.(int))
+	}
+
+	if v, ok := d.GetOkExists("require_special"); ok {
+		input.Complexity.RequireSpecial = boolPtr(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("expiration_days"); ok {
+		input.ExpirationDays = intPtr(v.(int))
+	}
+
+	return input
+}
+
+func resourcePasswordPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	// we just set a constant id since there's only one of this config per tenant
+	d.SetId("password_policy")
+	return resourcePasswordPolicyUpdate(ctx, d, m)
+}
+
+func resourcePasswordPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	if _, err := client.UpdatePasswordPolicy(ctx, newPasswordPolicyInput(d)); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to update password policy",
+			Detail:   err.Error(),
+		})
+	}
+
+	return append(diags, resourcePasswordPolicyRead(ctx, d, m)...)
+}
+
+func resourcePasswordPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	policy, err := client.GetPasswordPolicy(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read password policy",
+			Detail:   err.Error(),
+		})
+	}
+
+	if policy == nil {
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("min_length", policy.Complexity.MinLength); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("require_special", policy.Complexity.RequireSpecial); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("expiration_days", policy.ExpirationDays); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("min_distinct_chars", policy.Complexity.MinDistinctChars); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("require_uppercase", policy.Complexity.RequireUppercase); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("require_lowercase", policy.Complexity.RequireLowercase); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("require_number", policy.Complexity.RequireNumber); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("enforce_complexity_for_existing", policy.EnforceComplexityForExisting); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourcePasswordPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if !d.Get("force_destroy").(bool) {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Refusing to destroy password policy",
+			Detail:   "Set force_destroy = true and apply before destroying this resource.",
+		})
+	}
+
+	// there is no way to reset the policy, so it is only removed from state
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Password policy left in place",
+		Detail:   "The password policy can not be reset, so it has only been removed from the Terraform state.",
+	})
+}
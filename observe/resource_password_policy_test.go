@@ -0,0 +1,53 @@
+package observe
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObservePasswordPolicy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configPreamble + `
+				resource "observe_password_policy" "default" {
+					min_length      = 12
+					require_special = true
+					expiration_days = 90
+					force_destroy   = true
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_password_policy.default", "min_length", "12"),
+					resource.TestCheckResourceAttr("observe_password_policy.default", "require_special", "true"),
+					resource.TestCheckResourceAttr("observe_password_policy.default", "expiration_days", "90"),
+					resource.TestCheckResourceAttrSet("observe_password_policy.default", "min_distinct_chars"),
+				),
+			},
+			{
+				Config: configPreamble + `
+				resource "observe_password_policy" "default" {
+					min_length      = 12
+					require_special = false
+					expiration_days = 0
+					force_destroy   = true
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_password_policy.default", "require_special", "false"),
+					resource.TestCheckResourceAttr("observe_password_policy.default", "expiration_days", "0"),
+				),
+			},
+			{
+				ResourceName:            "observe_password_policy.default",
+				ImportState:             true,
+				ImportStateId:           "password_policy",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
@@ -0,0 +1,138 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/client/meta/types"
+	"github.com/observeinc/terraform-provider-observe/client/oid"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceRbacGroupmembers() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("rbac_group_members", "description"),
+		CreateContext: resourceRbacGroupmembersSet,
+		UpdateContext: resourceRbacGroupmembersSet,
+		ReadContext:   resourceRbacGroupmembersRead,
+		DeleteContext: resourceRbacGroupmembersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateOID(oid.TypeRbacGroup),
+				Description:      descriptions.Get("rbac_group_members", "schema", "group"),
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeUser),
+				},
+				Description: descriptions.Get("rbac_group_members", "schema", "users"),
+			},
+			"groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOID(oid.TypeRbacGroup),
+				},
+				Description: descriptions.Get("rbac_group_members", "schema", "groups"),
+			},
+		},
+	}
+}
+
+func newRbacGroupmembersConfig(data *schema.ResourceData) (users []types.UserIdScalar, groups []string, diags diag.Diagnostics) {
+	for _, v := range data.Get("users").(*schema.Set).List() {
+		user, _ := oid.NewOID(v.(string))
+		uid, err := types.StringToUserIdScalar(user.Id)
+		if err != nil {
+			return nil, nil, diag.Errorf("error parsing member user: %s", err.Error())
+		}
+		users = append(users, uid)
+	}
+	for _, v := range data.Get("groups").(*schema.Set).List() {
+		group, _ := oid.NewOID(v.(string))
+		groups = append(groups, group.Id)
+	}
+	return users, groups, nil
+}
+
+func resourceRbacGroupmembersSet(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	group, _ := oid.NewOID(data.Get("group").(string))
+
+	users, groups, diags := newRbacGroupmembersConfig(data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := client.SetRbacGroupmembers(ctx, group.Id, users, groups); err != nil {
+		return diag.Errorf("failed to set rbacgroupmembers: %s", err.Error())
+	}
+
+	data.SetId(group.Id)
+	return append(diags, resourceRbacGroupmembersRead(ctx, data, meta)...)
+}
+
+func resourceRbacGroupmembersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	// the membership listing does not fail for deleted groups
+	if _, err := client.GetRbacGroup(ctx, data.Id()); err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read rbacgroup: %s", err.Error())
+	}
+
+	members, err := client.GetRbacGroupmembers(ctx, data.Id())
+	if err != nil {
+		return diag.Errorf("failed to read rbacgroupmembers: %s", err.Error())
+	}
+
+	users := make([]interface{}, 0)
+	groups := make([]interface{}, 0)
+	for _, member := range members {
+		if member.MemberUserId != nil {
+			users = append(users, oid.UserOid(*member.MemberUserId).String())
+		} else if member.MemberGroupId != nil {
+			groups = append(groups, oid.RbacGroupOid(*member.MemberGroupId).String())
+		}
+	}
+
+	if err := data.Set("group", oid.RbacGroupOid(data.Id()).String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := data.Set("users", users); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := data.Set("groups", groups); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+func resourceRbacGroupmembersDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+	if _, err := client.SetRbacGroupmembers(ctx, data.Id(), nil, nil); err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			return diags
+		}
+		return diag.Errorf("failed to delete rbacgroupmembers: %s", err.Error())
+	}
+	return diags
+}
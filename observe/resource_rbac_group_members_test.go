@@ -0,0 +1,77 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveRbacGroupmembers(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				data "observe_user" "system" {
+					email = "%[1]s"
+				}
+
+				resource "observe_rbac_group" "example" {
+					name = "%[2]s"
+				}
+
+				resource "observe_rbac_group" "nested" {
+					name = "%[2]s-nested"
+				}
+
+				resource "observe_rbac_group_members" "example" {
+					group  = observe_rbac_group.example.oid
+					users  = [data.observe_user.system.oid]
+					groups = [observe_rbac_group.nested.oid]
+				}
+				`, systemUser(), randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("observe_rbac_group_members.example", "group", "observe_rbac_group.example", "oid"),
+					resource.TestCheckResourceAttr("observe_rbac_group_members.example", "users.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("observe_rbac_group_members.example", "users.*", "data.observe_user.system", "oid"),
+					resource.TestCheckResourceAttr("observe_rbac_group_members.example", "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("observe_rbac_group_members.example", "groups.*", "observe_rbac_group.nested", "oid"),
+				),
+			},
+			{
+				ResourceName:      "observe_rbac_group_members.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				data "observe_user" "system" {
+					email = "%[1]s"
+				}
+
+				resource "observe_rbac_group" "example" {
+					name = "%[2]s"
+				}
+
+				resource "observe_rbac_group" "nested" {
+					name = "%[2]s-nested"
+				}
+
+				resource "observe_rbac_group_members" "example" {
+					group = observe_rbac_group.example.oid
+					users = [data.observe_user.system.oid]
+				}
+				`, systemUser(), randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_rbac_group_members.example", "users.#", "1"),
+					resource.TestCheckResourceAttr("observe_rbac_group_members.example", "groups.#", "0"),
+				),
+			},
+		},
+	})
+}
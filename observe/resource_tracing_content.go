@@ -0,0 +1,147 @@
+package observe
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+// tracingSourceDatasets are the datasets both the tracing and LLM content are
+// built on. They must either all be set, or none at all.
+var tracingSourceDatasets = []string{
+	"span_raw_dataset",
+	"span_event_dataset",
+	"span_link_dataset",
+	"otel_metrics_dataset",
+}
+
+// tracingSourceDatasetSchema is shared by the tracing and LLM content.
+func tracingSourceDatasetSchema(description string) *schema.Schema {
+	s := contentSourceDatasetSchema(description, true)
+	s.RequiredWith = tracingSourceDatasets
+	return s
+}
+
+// tracingSourceDatasetIds returns the configured source datasets in the order
+// of tracingSourceDatasets, or nil if none are set.
+func tracingSourceDatasetIds(d *schema.ResourceData) ([]string, error) {
+	var ids []string
+	for _, key := range tracingSourceDatasets {
+		id, err := contentDatasetId(d, key)
+		if err != nil {
+			return nil, err
+		}
+		if id == nil {
+			return nil, nil
+		}
+		ids = append(ids, *id)
+	}
+	return ids, nil
+}
+
+func resourceTracingContent() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("tracing_content", "description"),
+		CreateContext: resourceTracingContentCreate,
+		ReadContext:   resourceTracingContentRead,
+		DeleteContext: resourceTracingContentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"span_raw_dataset":     tracingSourceDatasetSchema(descriptions.Get("tracing_content", "schema", "span_raw_dataset")),
+			"span_event_dataset":   tracingSourceDatasetSchema(descriptions.Get("tracing_content", "schema", "span_event_dataset")),
+			"span_link_dataset":    tracingSourceDatasetSchema(descriptions.Get("tracing_content", "schema", "span_link_dataset")),
+			"otel_metrics_dataset": tracingSourceDatasetSchema(descriptions.Get("tracing_content", "schema", "otel_metrics_dataset")),
+
+			"span_dataset":                               contentDatasetSchema(descriptions.Get("tracing_content", "schema", "span_dataset")),
+			"trace_dataset":                              contentDatasetSchema(descriptions.Get("tracing_content", "schema", "trace_dataset")),
+			"canonical_trace_dataset":                    contentDatasetSchema(descriptions.Get("tracing_content", "schema", "canonical_trace_dataset")),
+			"service_dataset":                            contentDatasetSchema(descriptions.Get("tracing_content", "schema", "service_dataset")),
+			"service_metrics_dataset":                    contentDatasetSchema(descriptions.Get("tracing_content", "schema", "service_metrics_dataset")),
+			"service_edge_metrics_dataset":               contentDatasetSchema(descriptions.Get("tracing_content", "schema", "service_edge_metrics_dataset")),
+			"service_inspector_metrics_dataset":          contentDatasetSchema(descriptions.Get("tracing_content", "schema", "service_inspector_metrics_dataset")),
+			"service_explorer_drilldown_metrics_dataset": contentDatasetSchema(descriptions.Get("tracing_content", "schema", "service_explorer_drilldown_metrics_dataset")),
+			"service_explorer_spans_dataset":             contentDatasetSchema(descriptions.Get("tracing_content", "schema", "service_explorer_spans_dataset")),
+			"deployment_dataset":                         contentDatasetSchema(descriptions.Get("tracing_content", "schema", "deployment_dataset")),
+			"error_tracking_metrics_dataset":             contentDatasetSchema(descriptions.Get("tracing_content", "schema", "error_tracking_metrics_dataset")),
+		},
+	}
+}
+
+func resourceTracingContentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	ids, err := tracingSourceDatasetIds(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var input *gql.TracingContentInput
+	if ids != nil {
+		input = &gql.TracingContentInput{
+			SpanRawDatasetId:     ids[0],
+			SpanEventDatasetId:   ids[1],
+			SpanLinkDatasetId:    ids[2],
+			OtelMetricsDatasetId: ids[3],
+		}
+	}
+
+	if _, err := client.InstallTracingContent(ctx, input); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to install tracing content",
+			Detail:   err.Error(),
+		})
+	}
+
+	// we just set a constant id since there's only one of this content per tenant
+	d.SetId("tracing_content")
+	return append(diags, resourceTracingContentRead(ctx, d, m)...)
+}
+
+func resourceTracingContentRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	client := m.(*observe.Client)
+
+	content, err := client.GetTracingContent(ctx)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read tracing content",
+			Detail:   err.Error(),
+		})
+	}
+
+	if content == nil {
+		d.SetId("")
+		return diags
+	}
+
+	return setContentDatasets(d, map[string]*string{
+		"span_raw_dataset":                           &content.SpanRawDatasetId,
+		"span_event_dataset":                         &content.SpanEventDatasetId,
+		"span_link_dataset":                          &content.SpanLinkDatasetId,
+		"otel_metrics_dataset":                       &content.OtelMetricsDatasetId,
+		"span_dataset":                               &content.SpanDatasetId,
+		"trace_dataset":                              &content.TraceDatasetId,
+		"canonical_trace_dataset":                    &content.CanonicalTraceDatasetId,
+		"service_dataset":                            &content.ServiceDatasetId,
+		"service_metrics_dataset":                    &content.ServiceMetricsDatasetId,
+		"service_edge_metrics_dataset":               &content.ServiceEdgeMetricsDatasetId,
+		"service_inspector_metrics_dataset":          &content.ServiceInspectorMetricsDatasetId,
+		"service_explorer_drilldown_metrics_dataset": &content.ServiceExplorerDrilldownMetricsDatasetId,
+		"service_explorer_spans_dataset":             &content.ServiceExplorerSpansDatasetId,
+		"deployment_dataset":                         &content.DeploymentDatasetId,
+		"error_tracking_metrics_dataset":             &content.ErrorTrackingMetricsDatasetId,
+	})
+}
+
+func resourceTracingContentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	// the API does not support uninstalling tracing content, so we only remove
+	// it from state
+	return diags
+}
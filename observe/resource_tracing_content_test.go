@@ -0,0 +1,49 @@
+package observe
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveTracingContent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// the source datasets must be set all together, or not at all
+				Config: configPreamble + `
+				resource "observe_tracing_content" "default" {
+					span_raw_dataset = "o:::dataset:12345"
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`all of .otel_metrics_dataset,span_event_dataset,span_link_dataset,span_raw_dataset. must be specified`),
+			},
+			{
+				Config: configPreamble + `
+				resource "observe_tracing_content" "default" {}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_tracing_content.default", "id", "tracing_content"),
+					resource.TestCheckResourceAttrSet("observe_tracing_content.default", "span_raw_dataset"),
+					resource.TestCheckResourceAttrSet("observe_tracing_content.default", "span_event_dataset"),
+					resource.TestCheckResourceAttrSet("observe_tracing_content.default", "span_link_dataset"),
+					resource.TestCheckResourceAttrSet("observe_tracing_content.default", "otel_metrics_dataset"),
+					resource.TestCheckResourceAttrSet("observe_tracing_content.default", "span_dataset"),
+					resource.TestCheckResourceAttrSet("observe_tracing_content.default", "trace_dataset"),
+					resource.TestCheckResourceAttrSet("observe_tracing_content.default", "service_dataset"),
+					resource.TestCheckResourceAttrSet("observe_tracing_content.default", "service_metrics_dataset"),
+				),
+			},
+			{
+				ResourceName:      "observe_tracing_content.default",
+				ImportState:       true,
+				ImportStateId:     "tracing_content",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,236 @@
+package observe
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	observe "github.com/observeinc/terraform-provider-observe/client"
+	gql "github.com/observeinc/terraform-provider-observe/client/meta"
+	"github.com/observeinc/terraform-provider-observe/observe/descriptions"
+)
+
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		Description:   descriptions.Get("user", "description"),
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      descriptions.Get("user", "schema", "email"),
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("user", "schema", "label"),
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("user", "schema", "timezone"),
+			},
+			"locale": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: descriptions.Get("user", "schema", "locale"),
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions.Get("user", "schema", "disabled"),
+			},
+			// computed values
+			"oid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("common", "schema", "oid"),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: descriptions.Get("user", "schema", "status"),
+			},
+		},
+	}
+}
+
+func newUserInput(data *schema.ResourceData) *gql.UserInput {
+	input := &gql.UserInput{}
+
+	if v, ok := data.GetOk("label"); ok {
+		input.Label = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("timezone"); ok {
+		input.Timezone = stringPtr(v.(string))
+	}
+
+	if v, ok := data.GetOk("locale"); ok {
+		input.Locale = stringPtr(v.(string))
+	}
+
+	// only send the status on change, to leave pending invites untouched
+	if data.HasChange("disabled") {
+		status := gql.UserStatusUserstatusactive
+		if data.Get("disabled").(bool) {
+			status = gql.UserStatusUserstatusdisabled
+		}
+		input.Status = &status
+	}
+
+	return input
+}
+
+func resourceUserCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	email := data.Get("email").(string)
+	input := newUserInput(data)
+
+	users, err := client.ListUsers(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list users: %s", err.Error())
+	}
+
+	var existing *gql.User
+	for i, u := range users {
+		if u.Email == email {
+			existing = &users[i]
+			break
+		}
+	}
+
+	// users are deactivated rather than deleted on destroy, so re-creating
+	// the resource should bring back the existing user.
+	if existing != nil {
+		if existing.Status != gql.UserStatusUserstatusdisabled {
+			return diag.Errorf("user %q already exists, import it with id %s", email, existing.Id.String())
+		}
+
+		status := gql.UserStatusUserstatusactive
+		if data.Get("disabled").(bool) {
+			status = gql.UserStatusUserstatusdisabled
+		}
+		input.Status = &status
+
+		result, err := client.UpdateUser(ctx, existing.Id.String(), input)
+		if err != nil {
+			return diag.Errorf("failed to reactivate user: %s", err.Error())
+		}
+		data.SetId(result.Id.String())
+		return append(diags, resourceUserRead(ctx, data, meta)...)
+	}
+
+	// the status of an invited user can only be changed once it exists
+	input.Status = nil
+	input.Email = &email
+
+	result, err := client.InviteUser(ctx, input)
+	if err != nil {
+		return diag.Errorf("failed to invite user: %s", err.Error())
+	}
+
+	data.SetId(result.Id.String())
+
+	if data.Get("disabled").(bool) {
+		status := gql.UserStatusUserstatusdisabled
+		if _, err := client.UpdateUser(ctx, data.Id(), &gql.UserInput{Status: &status}); err != nil {
+			return diag.Errorf("failed to deactivate user: %s", err.Error())
+		}
+	}
+
+	return append(diags, resourceUserRead(ctx, data, meta)...)
+}
+
+func resourceUserRead(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	user, err := client.GetUser(ctx, data.Id())
+	if err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			data.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read user: %s", err.Error())
+	}
+
+	if user.Status == gql.UserStatusUserstatusdeleted {
+		data.SetId("")
+		return nil
+	}
+
+	if err := data.Set("email", user.Email); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("label", user.Label); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("timezone", user.Timezone); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("locale", user.Locale); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("disabled", user.Status == gql.UserStatusUserstatusdisabled); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("status", userStatusToString(user.Status)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := data.Set("oid", user.Oid().String()); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceUserUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	if _, err := client.UpdateUser(ctx, data.Id(), newUserInput(data)); err != nil {
+		return diag.Errorf("failed to update user: %s", err.Error())
+	}
+
+	return append(diags, resourceUserRead(ctx, data, meta)...)
+}
+
+func resourceUserDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	client := meta.(*observe.Client)
+
+	status := gql.UserStatusUserstatusdisabled
+	if _, err := client.UpdateUser(ctx, data.Id(), &gql.UserInput{Status: &status}); err != nil {
+		if gql.HasErrorCode(err, gql.ErrNotFound) {
+			return diags
+		}
+		return diag.Errorf("failed to deactivate user: %s", err.Error())
+	}
+	return diags
+}
+
+// userStatusToString strips the redundant prefix of the status enum values,
+// e.g. UserStatusIdpDisabled becomes idp_disabled.
+func userStatusToString(status gql.UserStatus) string {
+	return toSnake(strings.TrimPrefix(string(status), "UserStatus"))
+}
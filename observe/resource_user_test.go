@@ -0,0 +1,80 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccObserveUserInvite(t *testing.T) {
+	randomPrefix := acctest.RandomWithPrefix("tf")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_user" "example" {
+					email    = "%[1]s@example.com"
+					label    = "%[1]s"
+					timezone = "America/Los_Angeles"
+				}
+
+				resource "observe_rbac_group" "example" {
+					name = "%[1]s"
+				}
+
+				resource "observe_rbac_group_member" "example" {
+					group = observe_rbac_group.example.oid
+					member {
+						user = observe_user.example.oid
+					}
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_user.example", "email", randomPrefix+"@example.com"),
+					resource.TestCheckResourceAttr("observe_user.example", "label", randomPrefix),
+					resource.TestCheckResourceAttr("observe_user.example", "timezone", "America/Los_Angeles"),
+					resource.TestCheckResourceAttr("observe_user.example", "disabled", "false"),
+					resource.TestCheckResourceAttr("observe_user.example", "status", "created"),
+					resource.TestCheckResourceAttrPair("observe_rbac_group_member.example", "member.0.user", "observe_user.example", "oid"),
+				),
+			},
+			{
+				ResourceName:      "observe_user.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_user" "example" {
+					email    = "%[1]s@example.com"
+					label    = "%[1]s-updated"
+					timezone = "Europe/Berlin"
+					disabled = true
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_user.example", "label", randomPrefix+"-updated"),
+					resource.TestCheckResourceAttr("observe_user.example", "timezone", "Europe/Berlin"),
+					resource.TestCheckResourceAttr("observe_user.example", "disabled", "true"),
+					resource.TestCheckResourceAttr("observe_user.example", "status", "disabled"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(configPreamble+`
+				resource "observe_user" "example" {
+					email = "%[1]s@example.com"
+					label = "%[1]s-updated"
+				}
+				`, randomPrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("observe_user.example", "disabled", "false"),
+				),
+			},
+		},
+	})
+}